@@ -0,0 +1,44 @@
+package domain
+
+import "strings"
+
+// spendAmmunition takes one piece of ammunition out of the inventory and remembers it for recovery
+func (c *Character) spendAmmunition(name string) error {
+	i := c.findItem(name)
+	if i < 0 {
+		return ErrOutOfAmmunition
+	}
+	spent := c.Inventory[i]
+	if _, err := c.RemoveItem(spent.Name, 1); err != nil {
+		return err
+	}
+
+	for j := range c.SpentAmmunition {
+		if strings.EqualFold(c.SpentAmmunition[j].Name, spent.Name) {
+			c.SpentAmmunition[j].Quantity++
+			return nil
+		}
+	}
+	spent.Quantity, spent.Notes, spent.Equipped = 1, "", false
+	c.SpentAmmunition = append(c.SpentAmmunition, spent)
+	return nil
+}
+
+// RecoverAmmunition puts half of the ammunition spent since the last recovery, rounded down, back in the
+// inventory and returns what was recovered
+// D&D 5e rule: at the end of the battle, you can recover half your expended ammunition by searching the battlefield
+func (c *Character) RecoverAmmunition() ([]InventoryItem, error) {
+	var recovered []InventoryItem
+	for _, spent := range c.SpentAmmunition {
+		spent.Quantity /= 2
+		if spent.Quantity == 0 {
+			continue
+		}
+		if _, err := c.AddItem(spent); err != nil {
+			return recovered, err
+		}
+		recovered = append(recovered, spent)
+	}
+	c.SpentAmmunition = nil
+	return recovered, nil
+}
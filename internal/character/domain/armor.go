@@ -0,0 +1,116 @@
+package domain
+
+import (
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ArmorStats describes worn armor or a shield, copied from the equipment catalog when it is equipped
+type ArmorStats struct {
+	Name                string `json:"name"`
+	Category            string `json:"category"` // Light, Medium, Heavy or Shield
+	BaseAC              int    `json:"base_ac"`
+	DexBonus            bool   `json:"dex_bonus,omitempty"`
+	MaxDexBonus         int    `json:"max_dex_bonus,omitempty"` // 0 means the full Dex bonus applies
+	StrMinimum          int    `json:"str_minimum,omitempty"`
+	StealthDisadvantage bool   `json:"stealth_disadvantage,omitempty"`
+	MagicBonus          int    `json:"magic_bonus,omitempty"` // e.g. 1 for +1 chain mail
+}
+
+// IsShield checks if the stats describe a shield rather than body armor
+func (a ArmorStats) IsShield() bool {
+	return a.Category == "Shield"
+}
+
+// DisplayName returns the name including any magic bonus, e.g. "+1 Chain Mail"
+func (a ArmorStats) DisplayName() string {
+	if a.MagicBonus > 0 {
+		return fmt.Sprintf("+%d %s", a.MagicBonus, a.Name)
+	}
+	return a.Name
+}
+
+// ArmorClass returns the AC the armor gives, or the bonus a shield adds, including any magic bonus
+// The Dex modifier is applied by the equipment catalog's armor rules, see equipmentdomain.Equipment.CalculateAC
+func (a ArmorStats) ArmorClass(dexModifier int) int {
+	item := equipmentdomain.Equipment{
+		Name:          a.Name,
+		ArmorCategory: a.Category,
+		ArmorClass:    equipmentdomain.ArmorClass{Base: a.BaseAC, DexBonus: a.DexBonus, MaxBonus: a.MaxDexBonus},
+	}
+	return item.CalculateAC(dexModifier) + a.MagicBonus
+}
+
+// ParseMagicBonus splits a magic bonus prefix from an item name, e.g. "+1 Chain Mail" gives "Chain Mail" and 1
+func ParseMagicBonus(name string) (string, int) {
+	name = strings.TrimSpace(name)
+	prefix, rest, found := strings.Cut(name, " ")
+	if !found || !strings.HasPrefix(prefix, "+") {
+		return name, 0
+	}
+	bonus, err := strconv.Atoi(prefix[1:])
+	if err != nil || bonus < 1 {
+		return name, 0
+	}
+	return strings.TrimSpace(rest), bonus
+}
+
+// FindArmor looks up armor or a shield in the equipment catalog
+// Short names such as "plate" also match "Plate Armor", and a "+1" prefix is read as a magic bonus
+func FindArmor(catalog equipmentdomain.EquipmentRepository, name string) (ArmorStats, error) {
+	baseName, bonus := ParseMagicBonus(name)
+
+	item, err := catalog.FindByName(baseName)
+	if err != nil {
+		item, err = catalog.FindByName(baseName + " armor")
+	}
+	if err != nil {
+		return ArmorStats{}, fmt.Errorf("%w: %q is not in the equipment catalog", ErrUnknownArmor, name)
+	}
+	if item.ArmorCategory == "" {
+		return ArmorStats{}, fmt.Errorf("%w: %s is not armor", ErrUnknownArmor, item.Name)
+	}
+
+	return ArmorStats{
+		Name:                item.Name,
+		Category:            item.ArmorCategory,
+		BaseAC:              item.ArmorClass.Base,
+		DexBonus:            item.ArmorClass.DexBonus,
+		MaxDexBonus:         item.ArmorClass.MaxBonus,
+		StrMinimum:          item.StrMinimum,
+		StealthDisadvantage: item.StealthDisadvantage,
+		MagicBonus:          bonus,
+	}, nil
+}
+
+// WearArmor puts on body armor, replacing any armor already worn
+func (c *Character) WearArmor(armor ArmorStats) error {
+	if armor.IsShield() {
+		return fmt.Errorf("%w: %s is a shield", ErrUnknownArmor, armor.Name)
+	}
+	c.Armor = armor.DisplayName()
+	c.ArmorStats = &armor
+	c.syncEquippedItems()
+	return nil
+}
+
+// WearShield takes up a shield, replacing any shield already carried
+func (c *Character) WearShield(shield ArmorStats) error {
+	if !shield.IsShield() {
+		return fmt.Errorf("%w: %s is not a shield", ErrUnknownArmor, shield.Name)
+	}
+
+	// A shield takes up the off hand
+	if isTwoHanded(c.WeaponStats) {
+		return ErrHandsFull
+	}
+	if c.OffHand != "" {
+		return fmt.Errorf("%s %w", SlotOffHand, ErrSlotOccupied)
+	}
+	c.Shield = shield.DisplayName()
+	c.ShieldStats = &shield
+	c.syncEquippedItems()
+	return nil
+}
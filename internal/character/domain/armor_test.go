@@ -0,0 +1,61 @@
+package domain
+
+import (
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// armorCatalog is an in-memory equipment catalog for looking up armor
+type armorCatalog []equipmentdomain.Equipment
+
+func (c armorCatalog) LoadAll() ([]equipmentdomain.Equipment, error) {
+	return c, nil
+}
+
+func (c armorCatalog) FindByName(name string) (*equipmentdomain.Equipment, error) {
+	for i := range c {
+		if strings.EqualFold(c[i].Name, name) {
+			return &c[i], nil
+		}
+	}
+	return nil, errors.New("equipment not found")
+}
+
+func (c armorCatalog) FindByCategory(category string) ([]equipmentdomain.Equipment, error) {
+	var items []equipmentdomain.Equipment
+	for _, item := range c {
+		if strings.EqualFold(item.Category, category) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+var testArmorCatalog = armorCatalog{
+	{Name: "Leather Armor", Category: "Armor", ArmorCategory: "Light", ArmorClass: equipmentdomain.ArmorClass{Base: 11, DexBonus: true}},
+	{Name: "Chain Mail", Category: "Armor", ArmorCategory: "Heavy", ArmorClass: equipmentdomain.ArmorClass{Base: 16}, StrMinimum: 13, StealthDisadvantage: true},
+	{Name: "Plate Armor", Category: "Armor", ArmorCategory: "Heavy", ArmorClass: equipmentdomain.ArmorClass{Base: 18}, StrMinimum: 15, StealthDisadvantage: true},
+	{Name: "Longsword", Category: "Weapon"},
+}
+
+func TestFindArmor(t *testing.T) {
+	plate, err := FindArmor(testArmorCatalog, "plate")
+	if err != nil || plate.Name != "Plate Armor" || plate.BaseAC != 18 || plate.StrMinimum != 15 {
+		t.Errorf("Expected plate to find Plate Armor, got %+v (err %v)", plate, err)
+	}
+
+	chain, err := FindArmor(testArmorCatalog, "+1 chain mail")
+	if err != nil || chain.DisplayName() != "+1 Chain Mail" || chain.ArmorClass(3) != 17 {
+		t.Errorf("Expected +1 Chain Mail with AC 17, got %+v (err %v)", chain, err)
+	}
+}
+
+func TestFindArmor_UnknownArmor(t *testing.T) {
+	for _, name := range []string{"mithral robe", "longsword"} {
+		if _, err := FindArmor(testArmorCatalog, name); !errors.Is(err, ErrUnknownArmor) {
+			t.Errorf("Expected ErrUnknownArmor for %q, got %v", name, err)
+		}
+	}
+}
@@ -1,37 +1,94 @@
 package domain
 
-import (
-	"math/rand"
-	"time"
-)
+import "strings"
 
-// asiLevels defines which levels grant Ability Score Improvements
+// MaxAbilityScore is the highest score an Ability Score Improvement or feat can raise an ability to
+const MaxAbilityScore = 20
+
+// asiLevels defines which class levels grant Ability Score Improvements
 var asiLevels = map[int]bool{4: true, 8: true, 12: true, 16: true, 19: true}
 
-// ApplySRDAbilityScoreImprovements applies ASI to a character for levels gained
-func (c *Character) ApplySRDAbilityScoreImprovements(oldLevel, newLevel int) {
-	rand.Seed(time.Now().UnixNano())
-	for lvl := oldLevel + 1; lvl <= newLevel; lvl++ {
-		if asiLevels[lvl] {
-			// Pick two different stats to increase by 1
-			stats := []string{"Str", "Dex", "Con", "Int", "Wis", "Cha"}
-			rand.Shuffle(len(stats), func(i, j int) { stats[i], stats[j] = stats[j], stats[i] })
-			for i := 0; i < 2; i++ {
-				switch stats[i] {
-				case "Str":
-					c.Str++
-				case "Dex":
-					c.Dex++
-				case "Con":
-					c.Con++
-				case "Int":
-					c.Int++
-				case "Wis":
-					c.Wis++
-				case "Cha":
-					c.Cha++
-				}
-			}
+// extraASILevels lists the additional Ability Score Improvements some classes get
+var extraASILevels = map[string]map[int]bool{
+	"fighter": {6: true, 14: true},
+	"rogue":   {10: true},
+}
+
+// abilityScoreImprovements counts the Ability Score Improvements a class grants between two of its levels
+// D&D 5e rule: ASIs come from class levels, so a multiclass character gains them per class
+func abilityScoreImprovements(class string, fromLevel, toLevel int) int {
+	count := 0
+	for lvl := fromLevel + 1; lvl <= toLevel; lvl++ {
+		if asiLevels[lvl] || extraASILevels[strings.ToLower(class)][lvl] {
+			count++
+		}
+	}
+	return count
+}
+
+// ImproveAbilityScores spends a pending Ability Score Improvement
+// D&D 5e rule: either +2 to one ability or +1 to two different abilities, and no score can exceed 20
+func (c *Character) ImproveAbilityScores(abilities []string) error {
+	if c.PendingASI <= 0 {
+		return ErrNoPendingASI
+	}
+
+	increases := make(map[string]int)
+	switch len(abilities) {
+	case 1:
+		increases[strings.ToUpper(abilities[0])] = 2
+	case 2:
+		increases[strings.ToUpper(abilities[0])]++
+		increases[strings.ToUpper(abilities[1])]++
+	}
+	if len(increases) != len(abilities) || len(increases) == 0 {
+		return ErrInvalidAbilityScoreImprovement
+	}
+
+	for ability, increase := range increases {
+		if !isAbility(ability) {
+			return ErrInvalidAbilityScoreImprovement
 		}
+		if c.BaseAbilityScore(ability)+increase > MaxAbilityScore {
+			return ErrAbilityScoreCap
+		}
+	}
+
+	oldMaxHP := c.MaxHitPoints()
+	for ability, increase := range increases {
+		c.adjustAbilityScore(ability, increase)
+	}
+	c.PendingASI--
+	c.applyMaxHitPointChange(oldMaxHP)
+	c.RefreshResources()
+
+	return nil
+}
+
+// isAbility checks if a string is one of the six ability abbreviations
+func isAbility(ability string) bool {
+	for _, a := range Abilities {
+		if strings.EqualFold(a, ability) {
+			return true
+		}
+	}
+	return false
+}
+
+// adjustAbilityScore changes an ability score by delta
+func (c *Character) adjustAbilityScore(ability string, delta int) {
+	switch strings.ToUpper(ability) {
+	case "STR":
+		c.Str += delta
+	case "DEX":
+		c.Dex += delta
+	case "CON":
+		c.Con += delta
+	case "INT":
+		c.Int += delta
+	case "WIS":
+		c.Wis += delta
+	case "CHA":
+		c.Cha += delta
 	}
 }
@@ -0,0 +1,69 @@
+package domain
+
+import "testing"
+
+func TestCharacter_ImproveAbilityScores(t *testing.T) {
+	tests := []struct {
+		name        string
+		abilities   []string
+		expectedErr error
+		expectedStr int
+		expectedCon int
+	}{
+		{name: "Plus two to one ability", abilities: []string{"STR"}, expectedStr: 18, expectedCon: 14},
+		{name: "Plus one to two abilities", abilities: []string{"str", "CON"}, expectedStr: 17, expectedCon: 15},
+		{name: "Same ability twice", abilities: []string{"STR", "STR"}, expectedErr: ErrInvalidAbilityScoreImprovement, expectedStr: 16, expectedCon: 14},
+		{name: "Unknown ability", abilities: []string{"LUCK"}, expectedErr: ErrInvalidAbilityScoreImprovement, expectedStr: 16, expectedCon: 14},
+		{name: "Capped at 20", abilities: []string{"DEX"}, expectedErr: ErrAbilityScoreCap, expectedStr: 16, expectedCon: 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Class: "fighter", Level: 4, Str: 16, Dex: 19, Con: 14, PendingASI: 1}
+
+			if err := char.ImproveAbilityScores(tt.abilities); err != tt.expectedErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if char.Str != tt.expectedStr || char.Con != tt.expectedCon {
+				t.Errorf("Expected STR %d and CON %d, got %d and %d", tt.expectedStr, tt.expectedCon, char.Str, char.Con)
+			}
+		})
+	}
+}
+
+func TestCharacter_PendingASIFromLevels(t *testing.T) {
+	char := NewCharacter("Test", "human", "fighter", 1, 14, 12, 14, 10, 10, 10, "soldier", nil)
+
+	if _, err := char.SetClassLevel("fighter", 6); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.PendingASI != 2 {
+		t.Errorf("Expected 2 pending ASIs for a level 6 fighter, got %d", char.PendingASI)
+	}
+}
+
+func TestCharacter_TakeFeat(t *testing.T) {
+	char := &Character{Class: "wizard", Level: 4, Con: 13, Dex: 14, CurrentHP: 10, PendingASI: 2}
+	initiative, maxHP := char.Initiative(), char.MaxHitPoints()
+
+	if err := char.TakeFeat("resilient", "con"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Con != 14 || !char.IsProficientInSave("CON") {
+		t.Errorf("Expected Resilient (CON) to raise Con to 14 and grant the save, got Con %d", char.Con)
+	}
+	if char.MaxHitPoints() != maxHP+4 || char.CurrentHP != 14 {
+		t.Errorf("Expected the Con increase to add 4 HP, got max %d current %d", char.MaxHitPoints(), char.CurrentHP)
+	}
+
+	if err := char.TakeFeat("Alert", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Initiative() != initiative+5 {
+		t.Errorf("Expected Alert to add 5 to initiative, got %d", char.Initiative())
+	}
+
+	if err := char.TakeFeat("Tough", ""); err != ErrNoPendingASI {
+		t.Errorf("Expected ErrNoPendingASI, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RollDice rolls a dice expression such as "2d4+2", "1d8-1" or a flat "1", using roll for each die
+// On a critical hit every die is rolled twice, following D&D 5e rules
+func RollDice(expr string, critical bool, roll func(sides int) int) (int, error) {
+	expr = strings.ReplaceAll(strings.ToLower(expr), " ", "")
+	if expr == "" {
+		return 0, fmt.Errorf("%w: empty dice expression", ErrInvalidDice)
+	}
+
+	total := 0
+	for _, term := range strings.FieldsFunc(strings.ReplaceAll(expr, "-", "+-"), func(r rune) bool { return r == '+' }) {
+		sign := 1
+		if strings.HasPrefix(term, "-") {
+			sign, term = -1, term[1:]
+		}
+
+		countText, sidesText, isDice := strings.Cut(term, "d")
+		if !isDice {
+			value, err := strconv.Atoi(term)
+			if err != nil {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDice, expr)
+			}
+			total += sign * value
+			continue
+		}
+
+		count, sides := 1, 0
+		var err error
+		if countText != "" {
+			if count, err = strconv.Atoi(countText); err != nil {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDice, expr)
+			}
+		}
+		if sides, err = strconv.Atoi(sidesText); err != nil || count < 1 || sides < 1 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDice, expr)
+		}
+		if critical {
+			count *= 2
+		}
+		for i := 0; i < count; i++ {
+			total += sign * roll(sides)
+		}
+	}
+	return total, nil
+}
+
+// AttackResult describes a single rolled weapon attack
+type AttackResult struct {
+	Attack         Attack
+	Mode           RollMode
+	D20            int // the d20 kept after advantage or disadvantage
+	Total          int // d20 plus the attack bonus
+	Critical       bool
+	Fumble         bool   // a natural 1 always misses
+	PowerAttack    string // the feat used for -5 to hit and +10 damage, if any
+	Damage         int
+	Ammunition     string // ammunition spent on the attack, if any
+	AmmunitionLeft int
+}
+
+// CriticalThreshold returns the lowest d20 roll that scores a critical hit with a weapon attack
+// The Champion's Improved Critical lowers it to 19 and Superior Critical to 18
+func (c *Character) CriticalThreshold() int {
+	switch {
+	case c.HasFeature("Superior Critical"):
+		return 18
+	case c.HasFeature("Improved Critical"):
+		return 19
+	}
+	return 20
+}
+
+// MakeAttack rolls an attack with the main-hand or off-hand weapon, using up a piece of ammunition if the
+// weapon needs it
+// With powerAttack the character takes -5 to hit for +10 damage, which Great Weapon Master allows with heavy melee
+// weapons and Sharpshooter with ranged weapons
+// D&D 5e rules: a natural 20 is a critical hit that rolls the damage dice twice, and a natural 1 misses
+func (c *Character) MakeAttack(offHand, powerAttack bool, roll func(sides int) int) (AttackResult, error) {
+	var result AttackResult
+
+	if c.Dead {
+		return result, ErrCharacterDead
+	}
+	if c.IsIncapacitated() {
+		return result, ErrIncapacitated
+	}
+
+	name, stats := c.Weapon, c.WeaponStats
+	if offHand {
+		name, stats = c.OffHand, c.OffHandStats
+	}
+	if name == "" {
+		slot := SlotMainHand
+		if offHand {
+			slot = SlotOffHand
+		}
+		return result, fmt.Errorf("%s: %w", slot, ErrSlotEmpty)
+	}
+
+	// Check the ammunition before rolling so an empty quiver doesn't waste the attack
+	ammunition := ""
+	if stats != nil && stats.HasProperty("Ammunition") {
+		ammunition = stats.Ammunition
+		if ammunition == "" || !c.HasItem(ammunition) {
+			return result, fmt.Errorf("%w for the %s", ErrOutOfAmmunition, stats.Name)
+		}
+	}
+
+	result.Attack = c.weaponAttack(name, stats, offHand)
+	if powerAttack {
+		result.PowerAttack = c.powerAttackFeat(result.Attack)
+		if result.PowerAttack == "" {
+			return result, fmt.Errorf("%w: %s", ErrNoPowerAttack, name)
+		}
+		result.Attack.AttackBonus -= 5
+	}
+	result.Mode = c.AttackRollMode()
+	result.D20 = rollD20(result.Mode, roll)
+	result.Total = result.D20 + result.Attack.AttackBonus
+	result.Critical = result.D20 >= c.CriticalThreshold()
+	result.Fumble = result.D20 == 1
+
+	if result.Attack.Damage != "" && !result.Fumble {
+		damage, err := RollDice(result.Attack.Damage, result.Critical, roll)
+		if err != nil {
+			return result, err
+		}
+		if result.PowerAttack != "" {
+			damage += 10
+		}
+		result.Damage = max(0, damage)
+	}
+
+	if ammunition != "" {
+		if err := c.spendAmmunition(ammunition); err != nil {
+			return result, err
+		}
+		result.Ammunition = ammunition
+		if i := c.findItem(ammunition); i >= 0 {
+			result.AmmunitionLeft = c.Inventory[i].Quantity
+		}
+	}
+	return result, nil
+}
+
+// powerAttackFeat returns the feat that lets the character make a -5/+10 power attack, or "" if none applies
+// Great Weapon Master works with heavy melee weapons and Sharpshooter with ranged weapons, both only when proficient
+func (c *Character) powerAttackFeat(attack Attack) string {
+	if !attack.Proficient {
+		return ""
+	}
+	heavy := false
+	for _, property := range attack.Properties {
+		heavy = heavy || strings.EqualFold(property, "Heavy")
+	}
+	switch {
+	case attack.IsMelee && heavy && c.HasFeat("Great Weapon Master"):
+		return "Great Weapon Master"
+	case attack.IsRanged && c.HasFeat("Sharpshooter"):
+		return "Sharpshooter"
+	}
+	return ""
+}
+
+// rollD20 rolls a d20, rolling twice and keeping the higher or lower roll with advantage or disadvantage
+func rollD20(mode RollMode, roll func(sides int) int) int {
+	d20 := roll(20)
+	switch mode.String() {
+	case "advantage":
+		d20 = max(d20, roll(20))
+	case "disadvantage":
+		d20 = min(d20, roll(20))
+	}
+	return d20
+}
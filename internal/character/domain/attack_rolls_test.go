@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+// fixedRoll returns a roll function that always rolls the given value, capped at the die size
+func fixedRoll(value int) func(sides int) int {
+	return func(sides int) int { return min(value, sides) }
+}
+
+func TestRollDice(t *testing.T) {
+	tests := []struct {
+		expr     string
+		critical bool
+		expected int
+	}{
+		{"2d4+2", false, 8},
+		{"1d8-1", false, 2},
+		{"1d6+3", true, 9},
+		{"1", false, 1},
+	}
+
+	for _, tt := range tests {
+		got, err := RollDice(tt.expr, tt.critical, fixedRoll(3))
+		if err != nil || got != tt.expected {
+			t.Errorf("RollDice(%q, %v) = %d, %v; expected %d", tt.expr, tt.critical, got, err, tt.expected)
+		}
+	}
+	if _, err := RollDice("d", false, fixedRoll(3)); !errors.Is(err, ErrInvalidDice) {
+		t.Errorf("Expected ErrInvalidDice, got %v", err)
+	}
+}
+
+func TestCharacter_MakeAttack_Ammunition(t *testing.T) {
+	shortbow := &WeaponStats{Name: "Shortbow", Category: "Simple", Range: "Ranged", Damage: "1d6", DamageType: "Piercing", NormalRange: 80, LongRange: 320, Properties: []string{"Ammunition", "Two-Handed"}, Ammunition: "Arrow"}
+	char := &Character{Class: "fighter", Level: 1, ProficiencyBonus: 2, Dex: 16, Weapon: "Shortbow", WeaponStats: shortbow, CurrentHP: 10}
+	if _, err := char.AddItem(InventoryItem{Name: "Arrow", Quantity: 3, Weight: 0.05}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		result, err := char.MakeAttack(false, false, fixedRoll(20))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !result.Critical || result.Total != 25 || result.Damage != 15 || result.AmmunitionLeft != 2-i {
+			t.Errorf("Unexpected attack result %+v", result)
+		}
+	}
+	if _, err := char.MakeAttack(false, false, fixedRoll(20)); !errors.Is(err, ErrOutOfAmmunition) {
+		t.Errorf("Expected ErrOutOfAmmunition, got %v", err)
+	}
+
+	recovered, err := char.RecoverAmmunition()
+	if err != nil || len(recovered) != 1 || recovered[0].Quantity != 1 {
+		t.Fatalf("Expected to recover 1 arrow, got %v (err %v)", recovered, err)
+	}
+	if i := char.findItem("Arrow"); i < 0 || char.Inventory[i].Weight != 0.05 || len(char.SpentAmmunition) != 0 {
+		t.Errorf("Expected the recovered arrow back in the inventory, got %v", char.Inventory)
+	}
+}
+
+func TestCharacter_MakeAttack_PowerAttack(t *testing.T) {
+	greatsword := &WeaponStats{Name: "Greatsword", Category: "Martial", Range: "Melee", Damage: "2d6", DamageType: "Slashing", Properties: []string{"Heavy", "Two-Handed"}}
+	char := &Character{Class: "fighter", Level: 4, ProficiencyBonus: 2, Str: 16, Weapon: "Greatsword", WeaponStats: greatsword, CurrentHP: 10}
+
+	if _, err := char.MakeAttack(false, true, fixedRoll(10)); !errors.Is(err, ErrNoPowerAttack) {
+		t.Errorf("Expected ErrNoPowerAttack without Great Weapon Master, got %v", err)
+	}
+
+	char.Feats = []CharacterFeat{{Name: "Great Weapon Master"}}
+	result, err := char.MakeAttack(false, true, fixedRoll(10))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// d20 10 + Str 3 + proficiency 2 - 5 to hit; 2d6 (6+6) + Str 3 + 10 damage
+	if result.PowerAttack != "Great Weapon Master" || result.Total != 10 || result.Damage != 25 {
+		t.Errorf("Unexpected power attack result %+v", result)
+	}
+
+	// Sharpshooter doesn't work with melee weapons
+	char.Feats = []CharacterFeat{{Name: "Sharpshooter"}}
+	if _, err := char.MakeAttack(false, true, fixedRoll(10)); !errors.Is(err, ErrNoPowerAttack) {
+		t.Errorf("Expected ErrNoPowerAttack for Sharpshooter with a greatsword, got %v", err)
+	}
+}
+
+func TestCharacter_MakeAttack_CriticalRange(t *testing.T) {
+	longsword := &WeaponStats{Name: "Longsword", Category: "Martial", Range: "Melee", Damage: "1d8", DamageType: "Slashing", Properties: []string{"Versatile"}}
+	tests := []struct {
+		name     string
+		level    int
+		subclass string
+		d20      int
+		critical bool
+	}{
+		{"no subclass", 3, "", 19, false},
+		{"Improved Critical", 3, "Champion", 19, true},
+		{"Improved Critical", 3, "Champion", 18, false},
+		{"Superior Critical", 15, "Champion", 18, true},
+		{"Superior Critical", 15, "Champion", 17, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Class: "fighter", Level: tt.level, ProficiencyBonus: 2, Str: 16, Weapon: "Longsword", WeaponStats: longsword, CurrentHP: 10,
+				Classes: []ClassLevel{{Class: "fighter", Level: tt.level, Subclass: tt.subclass}}}
+			result, err := char.MakeAttack(false, false, fixedRoll(tt.d20))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Critical != tt.critical {
+				t.Errorf("Expected critical %v on a %d, got %+v", tt.critical, tt.d20, result)
+			}
+		})
+	}
+}
+
+func TestCharacter_UseConsumable(t *testing.T) {
+	char := &Character{Class: "fighter", Level: 1, Con: 10, CurrentHP: 1}
+	if _, err := char.AddItem(InventoryItem{Name: "Potion of healing", Quantity: 2}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result, err := char.UseConsumable("potion of healing", fixedRoll(1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Rolled != 4 || char.CurrentHP != 5 || result.Remaining != 1 {
+		t.Errorf("Expected 2d4+2 rolling 4 into current HP, got %+v and %d HP", result, char.CurrentHP)
+	}
+
+	if _, err := char.AddItem(InventoryItem{Name: "Rope, hempen (50 feet)", Quantity: 1}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := char.UseConsumable("Rope, hempen (50 feet)", fixedRoll(1)); !errors.Is(err, ErrNotConsumable) {
+		t.Errorf("Expected ErrNotConsumable, got %v", err)
+	}
+}
@@ -1,32 +1,59 @@
 package domain
 
 import (
+	"fmt"
 	"strings"
 )
 
 // Character represents a D&D 5e character with all their attributes and abilities.
 type Character struct {
-	Name               string      `json:"name"`
-	Race               string      `json:"race"`
-	Class              string      `json:"class"`
-	Level              int         `json:"level"`
-	Str                int         `json:"str"`
-	Dex                int         `json:"dex"`
-	Con                int         `json:"con"`
-	Int                int         `json:"int"`
-	Wis                int         `json:"wis"`
-	Cha                int         `json:"cha"`
-	Background         string      `json:"background"`
-	ProficiencyBonus   int         `json:"proficiencyBonus"`
-	SkillProficiencies []string    `json:"skillProficiencies"`
-	SpellSlots         map[int]int `json:"spell_slots"`         // key: spell level, value: max slots
-	CurrentSpellSlots  map[int]int `json:"current_spell_slots"` // key: spell level, value: current slots available
-	Weapon             string      `json:"weapon"`
-	WeaponSlot         string      `json:"weapon_slot"`
-	Armor              string      `json:"armor,omitempty"`
-	Shield             string      `json:"shield,omitempty"`
-	KnownSpells        []string    `json:"knownSpells,omitempty"`
-	PreparedSpells     []string    `json:"preparedSpells,omitempty"`
+	Name               string          `json:"name"`
+	Race               string          `json:"race"`
+	Class              string          `json:"class"`             // primary class, the one the character started with
+	Level              int             `json:"level"`             // total character level across all classes
+	Classes            []ClassLevel    `json:"classes,omitempty"` // per-class levels and subclasses, set once the character levels up or picks a subclass
+	Str                int             `json:"str"`
+	Dex                int             `json:"dex"`
+	Con                int             `json:"con"`
+	Int                int             `json:"int"`
+	Wis                int             `json:"wis"`
+	Cha                int             `json:"cha"`
+	Background         string          `json:"background"`
+	ProficiencyBonus   int             `json:"proficiencyBonus"`
+	SkillProficiencies []string        `json:"skillProficiencies"`
+	SpellSlots         map[int]int     `json:"spell_slots"`                  // key: spell level, value: max slots
+	CurrentSpellSlots  map[int]int     `json:"current_spell_slots"`          // key: spell level, value: current slots available
+	PactSlots          map[int]int     `json:"pact_slots,omitempty"`         // Warlock Pact Magic, key: slot level, value: max slots
+	CurrentPactSlots   map[int]int     `json:"current_pact_slots,omitempty"` // Warlock Pact Magic slots currently available
+	Weapon             string          `json:"weapon"`
+	WeaponSlot         string          `json:"weapon_slot"`
+	Armor              string          `json:"armor,omitempty"`
+	Shield             string          `json:"shield,omitempty"`
+	WeaponStats        *WeaponStats    `json:"weapon_stats,omitempty"` // catalog stats of the wielded weapon, nil for improvised weapons
+	OffHand            string          `json:"off_hand,omitempty"`     // weapon wielded in the off hand for two-weapon fighting
+	OffHandStats       *WeaponStats    `json:"off_hand_stats,omitempty"`
+	ArmorStats         *ArmorStats     `json:"armor_stats,omitempty"`  // catalog stats of the worn armor
+	ShieldStats        *ArmorStats     `json:"shield_stats,omitempty"` // catalog stats of the carried shield
+	KnownSpells        []string        `json:"knownSpells,omitempty"`
+	PreparedSpells     []string        `json:"preparedSpells,omitempty"`
+	Concentration      string          `json:"concentration,omitempty"` // spell the character is concentrating on
+	CurrentHP          int             `json:"current_hp"`
+	TempHP             int             `json:"temp_hp,omitempty"`
+	MaxHPAdjustment    int             `json:"max_hp_adjustment,omitempty"` // e.g. Aid or a reduction from a curse
+	Dead               bool            `json:"dead,omitempty"`
+	HitDice            HitDicePool     `json:"hit_dice_pool"`
+	DeathSaves         DeathSaves      `json:"death_saves"`
+	PendingASI         int             `json:"pending_asi,omitempty"` // Ability Score Improvements not yet spent
+	Feats              []CharacterFeat `json:"feats,omitempty"`
+	FightingStyle      string          `json:"fighting_style,omitempty"`
+	Resources          []Resource      `json:"resources,omitempty"` // Limited-use class features such as Rage or Ki
+	Conditions         []string        `json:"conditions,omitempty"`
+	Exhaustion         int             `json:"exhaustion,omitempty"`
+	Inventory          []InventoryItem `json:"inventory,omitempty"`
+	SpentAmmunition    []InventoryItem `json:"spent_ammunition,omitempty"` // ammunition fired since the last recovery
+	Attuned            []string        `json:"attuned,omitempty"`          // magic items the character is attuned to
+	Coins              Purse           `json:"coins"`
+	VariantEncumbrance bool            `json:"variant_encumbrance,omitempty"` // use the optional Str x 5 / Str x 10 encumbrance rule
 }
 
 // NewCharacter creates a new Character instance with proper spell slot calculation.
@@ -51,10 +78,19 @@ func NewCharacter(name, race, class string, level, str, dex, con, int_, wis, cha
 	c.SpellSlots = c.GetSpellSlots()
 
 	// Initialize current spell slots to match max spell slots
-	c.CurrentSpellSlots = make(map[int]int)
-	for spellLevel, slots := range c.SpellSlots {
-		c.CurrentSpellSlots[spellLevel] = slots
-	}
+	c.CurrentSpellSlots = copySlots(c.SpellSlots)
+	c.PactSlots = c.GetPactSlots()
+	c.CurrentPactSlots = copySlots(c.PactSlots)
+
+	// New characters start at full health with all hit dice available
+	c.CurrentHP = c.MaxHitPoints()
+	c.HitDice = NewHitDicePool(c.ClassLevels())
+
+	// Characters created above 1st level still get to choose their Ability Score Improvements
+	c.PendingASI = abilityScoreImprovements(class, 0, level)
+
+	// Class resources such as Rage or Second Wind start fully charged
+	c.RefreshResources()
 
 	return c
 }
@@ -67,69 +103,50 @@ func ProficiencyBonus(level int) int {
 // ArmorClass calculates the character's AC based on armor, dexterity, and shield
 // This is D&D 5e business logic and belongs in the domain layer
 func (c *Character) ArmorClass() int {
-	baseAC := 10
-	dexMod := Modifier(c.Dex)
-
-	// Base AC from armor (D&D 5e rules)
-	switch c.Armor {
-	case "leather armor", "Leather Armor":
-		baseAC = 11 + dexMod
-	case "studded leather", "Studded Leather":
-		baseAC = 12 + dexMod
-	case "chain shirt", "Chain Shirt":
-		// Medium armor: max +2 dex bonus
-		if dexMod > 2 {
-			baseAC = 13 + 2
-		} else {
-			baseAC = 13 + dexMod
-		}
-	case "scale mail", "Scale Mail":
-		// Medium armor: max +2 dex bonus
-		if dexMod > 2 {
-			baseAC = 14 + 2
-		} else {
-			baseAC = 14 + dexMod
-		}
-	case "half plate", "Half Plate", "half plate armor", "Half Plate Armor":
-		// Medium armor: max +2 dex bonus
-		if dexMod > 2 {
-			baseAC = 15 + 2
-		} else {
-			baseAC = 15 + dexMod
-		}
-	case "chain mail", "Chain Mail":
-		// Heavy armor: no dex bonus
-		baseAC = 16
-	case "plate", "Plate", "plate armor", "Plate Armor":
-		// Heavy armor: no dex bonus
-		baseAC = 18
-	default:
+	var baseAC int
+	dexMod := Modifier(c.AbilityScore("DEX"))
+
+	// Base AC from the armor's catalog stats (D&D 5e rules)
+	if c.ArmorStats != nil {
+		baseAC = c.ArmorStats.ArmorClass(dexMod)
+
+		// Fighting Style: Defense only applies while wearing armor
+		baseAC += c.fightingStyle().ArmorClassBonus
+	} else {
 		// No armor: check for Unarmored Defense (D&D 5e class features)
-		classLower := strings.ToLower(c.Class)
-		switch classLower {
-		case "barbarian":
+		// A character with both classes only benefits from one, so the barbarian version wins
+		switch {
+		case c.ClassLevel("barbarian") > 0:
 			// Barbarian Unarmored Defense: 10 + Dex + Con
-			baseAC = 10 + dexMod + Modifier(c.Con)
-		case "monk":
+			baseAC = 10 + dexMod + Modifier(c.AbilityScore("CON"))
+		case c.ClassLevel("monk") > 0:
 			// Monk Unarmored Defense: 10 + Dex + Wis
-			baseAC = 10 + dexMod + Modifier(c.Wis)
+			baseAC = 10 + dexMod + Modifier(c.AbilityScore("WIS"))
 		default:
 			// Standard unarmored: 10 + dex modifier
 			baseAC = 10 + dexMod
 		}
+
+		// Draconic Resilience: unarmored AC of 13 + Dex modifier when that beats the other options
+		if c.HasFeature("Draconic Resilience") {
+			baseAC = max(baseAC, 13+dexMod)
+		}
 	}
 
-	// Shield bonus
-	if c.Shield != "" {
-		baseAC += 2
+	// Shield bonus, including any magic bonus
+	if c.ShieldStats != nil {
+		baseAC += c.ShieldStats.ArmorClass(dexMod)
 	}
 
+	// Magic items such as a Cloak of Protection
+	baseAC += c.magicItemArmorClassBonus()
+
 	return baseAC
 }
 
 // PassivePerception calculates passive perception (10 + Wis mod + proficiency if proficient)
 func (c *Character) PassivePerception() int {
-	wisModifier := Modifier(c.Wis)
+	wisModifier := Modifier(c.AbilityScore("WIS"))
 	passive := 10 + wisModifier
 
 	// Check if proficient in perception
@@ -140,17 +157,31 @@ func (c *Character) PassivePerception() int {
 		}
 	}
 
+	// Feats such as Observant
+	passive += c.featBonus(func(f Feat) int { return f.PassivePerceptionBonus })
+
 	return passive
 }
 
-// SpellcastingAbility returns the primary spellcasting ability for the character's class
+// SpellcastingAbility returns the spellcasting ability of the character's first spellcasting class
+// D&D 5e rule: a multiclass character uses each class's own ability for that class's spells
 func (c *Character) SpellcastingAbility() string {
-	switch c.Class {
-	case "wizard", "Wizard":
+	for _, cl := range c.ClassLevels() {
+		if ability := spellcastingAbility(casterKey(cl)); ability != "" {
+			return ability
+		}
+	}
+	return ""
+}
+
+// spellcastingAbility returns the spellcasting ability for a single class, or a subclass that grants spellcasting
+func spellcastingAbility(class string) string {
+	switch strings.ToLower(class) {
+	case "wizard", "eldritch knight", "arcane trickster":
 		return "INT"
-	case "cleric", "Cleric", "druid", "Druid", "ranger", "Ranger":
+	case "cleric", "druid", "ranger":
 		return "WIS"
-	case "sorcerer", "Sorcerer", "bard", "Bard", "paladin", "Paladin", "warlock", "Warlock":
+	case "sorcerer", "bard", "paladin", "warlock":
 		return "CHA"
 	default:
 		return ""
@@ -159,16 +190,10 @@ func (c *Character) SpellcastingAbility() string {
 
 // SpellcastingModifier returns the ability modifier used for spellcasting
 func (c *Character) SpellcastingModifier() int {
-	switch c.SpellcastingAbility() {
-	case "INT":
-		return Modifier(c.Int)
-	case "WIS":
-		return Modifier(c.Wis)
-	case "CHA":
-		return Modifier(c.Cha)
-	default:
-		return 0
+	if ability := c.SpellcastingAbility(); ability != "" {
+		return Modifier(c.AbilityScore(ability))
 	}
+	return 0
 }
 
 // SpellSaveDC calculates spell save DC (8 + proficiency + spellcasting modifier)
@@ -187,140 +212,279 @@ func (c *Character) SpellAttackBonus() int {
 	return c.ProficiencyBonus + c.SpellcastingModifier()
 }
 
-// IsSpellcaster checks if the character's class can cast spells
+// spellcasters lists the classes, and the subclasses of non-spellcasting classes, that can cast spells
+var spellcasters = map[string]bool{
+	"wizard":           true,
+	"sorcerer":         true,
+	"warlock":          true,
+	"bard":             true,
+	"cleric":           true,
+	"druid":            true,
+	"paladin":          true,
+	"ranger":           true,
+	"artificer":        true,
+	"eldritch knight":  true,
+	"arcane trickster": true,
+}
+
+// knownCasters lists the spellcasting classes and subclasses that learn spells permanently instead of preparing them
+var knownCasters = map[string]bool{
+	"sorcerer":         true,
+	"warlock":          true,
+	"bard":             true,
+	"eldritch knight":  true,
+	"arcane trickster": true,
+	"ranger":           true, // Rangers know spells in 5e
+}
+
+// subclassSpellLists maps the subclasses that give a non-spellcasting class spellcasting to the class spell list
+// they learn spells from
+var subclassSpellLists = map[string]string{
+	"eldritch knight":  "wizard",
+	"arcane trickster": "wizard",
+}
+
+// casterKey returns the lowercase name a class level's spellcasting is looked up by: the subclass for subclasses
+// that grant spellcasting, such as the Eldritch Knight fighter, and the class otherwise
+func casterKey(cl ClassLevel) string {
+	if subclass := strings.ToLower(cl.Subclass); subclassSpellLists[subclass] != "" {
+		return subclass
+	}
+	return strings.ToLower(cl.Class)
+}
+
+// IsSpellcaster checks if any of the character's classes can cast spells
 func (c *Character) IsSpellcaster() bool {
-	spellcasters := map[string]bool{
-		"wizard":           true,
-		"sorcerer":         true,
-		"warlock":          true,
-		"bard":             true,
-		"cleric":           true,
-		"druid":            true,
-		"paladin":          true,
-		"ranger":           true,
-		"artificer":        true,
-		"eldritch knight":  true,
-		"arcane trickster": true,
-	}
-	classLower := strings.ToLower(c.Class)
-	return spellcasters[classLower]
-}
-
-// IsPreparedCaster returns true if the class prepares spells (vs learning them)
+	for _, cl := range c.ClassLevels() {
+		if spellcasters[casterKey(cl)] {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPreparedCaster returns true if any of the character's classes prepares spells (vs learning them)
 // D&D 5e rule: Some classes learn spells permanently, others prepare daily
 func (c *Character) IsPreparedCaster() bool {
-	// Known caster classes that learn spells permanently
-	knownCasters := map[string]bool{
-		"sorcerer":         true,
-		"warlock":          true,
-		"bard":             true,
-		"eldritch knight":  true,
-		"arcane trickster": true,
-		"ranger":           true, // Rangers know spells in 5e
+	for _, cl := range c.ClassLevels() {
+		key := casterKey(cl)
+		if spellcasters[key] && !knownCasters[key] {
+			return true
+		}
 	}
+	return false
+}
 
-	classLower := strings.ToLower(c.Class)
-	// If it's a known caster, it's NOT a prepared caster
-	if knownCasters[classLower] {
-		return false
+// IsKnownCaster returns true if any of the character's classes learns spells permanently
+func (c *Character) IsKnownCaster() bool {
+	for _, cl := range c.ClassLevels() {
+		if knownCasters[casterKey(cl)] {
+			return true
+		}
 	}
-
-	// If it's a spellcaster but not a known caster, it's a prepared caster
-	return c.IsSpellcaster()
+	return false
 }
 
-// GetSpellSlots returns spell slots for the character based on class and level
-// D&D 5e rule: Different classes have different spell slot progressions
+// GetSpellSlots returns spell slots for the character based on class and level, with cantrips known at level 0
+// D&D 5e rule: Different classes have different spell slot progressions; a character with
+// more than one spellcasting class uses the multiclass spellcaster table instead.
+// Warlock Pact Magic slots are tracked separately, see GetPactSlots
 func (c *Character) GetSpellSlots() map[int]int {
-	classLower := strings.ToLower(c.Class)
-
-	switch classLower {
-	case "wizard", "cleric", "druid", "bard", "sorcerer":
-		slots := FullCasterSpellSlots(c.Level)
-		// Add cantrips (Level 0) for full casters
-		slots[0] = FullCasterCantrips(c.Level)
-		return slots
-	case "paladin", "ranger":
-		return HalfCasterSpellSlots(c.Level)
-	case "warlock":
-		return PactMagicSpellSlots(c.Level)
-	default:
-		return map[int]int{}
+	var casters []ClassLevel
+	for _, cl := range c.ClassLevels() {
+		switch spellcastingProgression(casterKey(cl)) {
+		case "full", "half", "third":
+			casters = append(casters, cl)
+		}
+	}
+
+	slots := map[int]int{}
+	switch {
+	case len(casters) > 1:
+		// The multiclass spellcaster table matches the full caster table by caster level
+		slots = FullCasterSpellSlots(c.multiclassCasterLevel())
+	case len(casters) == 1 && spellcastingProgression(casterKey(casters[0])) == "full":
+		slots = FullCasterSpellSlots(casters[0].Level)
+	case len(casters) == 1 && spellcastingProgression(casterKey(casters[0])) == "third":
+		slots = ThirdCasterSpellSlots(casters[0].Level)
+	case len(casters) == 1:
+		slots = HalfCasterSpellSlots(casters[0].Level)
+	}
+
+	if cantrips := c.MaxCantrips(); cantrips > 0 {
+		slots[0] = cantrips
+	}
+	return slots
+}
+
+// GetPactSlots returns the Warlock Pact Magic slots based on the character's warlock level
+// D&D 5e rule: Pact Magic slots recharge on a short rest and don't combine with other spellcasting classes
+func (c *Character) GetPactSlots() map[int]int {
+	warlockLevel := c.ClassLevel("warlock")
+	if warlockLevel == 0 {
+		return nil
 	}
+
+	slots := PactMagicSpellSlots(warlockLevel)
+	delete(slots, 0)
+	return slots
+}
+
+// refreshPactSlots recalculates Pact Magic slots after a level change, keeping track of slots already spent
+func (c *Character) refreshPactSlots() {
+	spent := 0
+	for slotLevel, slots := range c.PactSlots {
+		spent += slots - c.CurrentPactSlots[slotLevel]
+	}
+
+	c.PactSlots = c.GetPactSlots()
+	c.CurrentPactSlots = nil
+	for slotLevel, slots := range c.PactSlots {
+		if c.CurrentPactSlots == nil {
+			c.CurrentPactSlots = make(map[int]int)
+		}
+		c.CurrentPactSlots[slotLevel] = max(0, slots-spent)
+	}
+}
+
+// copySlots returns a copy of a spell slot map, used to refill current slots from the maximum
+func copySlots(slots map[int]int) map[int]int {
+	if slots == nil {
+		return nil
+	}
+	current := make(map[int]int, len(slots))
+	for spellLevel, n := range slots {
+		current[spellLevel] = n
+	}
+	return current
 }
 
 // Initiative calculates initiative bonus (Dex modifier + class bonuses)
 // D&D 5e rule: Initiative = Dex modifier, with class-specific bonuses
 func (c *Character) Initiative() int {
-	initiative := Modifier(c.Dex)
+	initiative := Modifier(c.AbilityScore("DEX"))
 
 	// Class-specific initiative bonuses (D&D 5e rules)
-	classLower := strings.ToLower(c.Class)
-	switch classLower {
-	case "bard":
-		// Jack of All Trades: add half proficiency to initiative (from level 2)
-		if c.Level >= 2 {
-			initiative += c.ProficiencyBonus / 2
-		}
-		// Future: could add other class features like Feral Instinct for Barbarian
+	// Jack of All Trades: add half proficiency to initiative (from bard level 2)
+	if c.ClassLevel("bard") >= 2 {
+		initiative += c.ProficiencyBonus / 2
 	}
+	// Feral Instinct gives advantage instead of a bonus, see InitiativeMode
+
+	// Feats such as Alert
+	initiative += c.featBonus(func(f Feat) int { return f.InitiativeBonus })
 
 	return initiative
 }
 
-// MaxHitPoints calculates maximum hit points based on class and level
-// D&D 5e rule: Class hit die + Con modifier per level
-func (c *Character) MaxHitPoints() int {
-	conMod := Modifier(c.Con)
-
-	// Class hit dice (D&D 5e rules)
-	classLower := strings.ToLower(c.Class)
-	var baseHP int
+// HitDie returns the size of the hit die of the character's primary class
+func (c *Character) HitDie() int {
+	return hitDieForClass(c.Class)
+}
 
-	switch classLower {
+// hitDieForClass returns the size of a class's hit die
+// D&D 5e rule: each class has a fixed hit die (d6 to d12)
+func hitDieForClass(class string) int {
+	switch strings.ToLower(class) {
 	case "barbarian":
-		// d12 hit die: max at 1st level, average (7) afterwards
-		baseHP = 12 + (c.Level-1)*7
+		return 12
 	case "fighter", "paladin", "ranger":
-		// d10 hit die: max at 1st level, average (6) afterwards
-		baseHP = 10 + (c.Level-1)*6
+		return 10
 	case "bard", "cleric", "druid", "monk", "rogue", "warlock":
-		// d8 hit die: max at 1st level, average (5) afterwards
-		baseHP = 8 + (c.Level-1)*5
+		return 8
 	case "artificer", "sorcerer", "wizard":
-		// d6 hit die: max at 1st level, average (4) afterwards
-		baseHP = 6 + (c.Level-1)*4
+		return 6
 	default:
 		// Default to d8 if class unknown
-		baseHP = 8 + (c.Level-1)*5
+		return 8
+	}
+}
+
+// MaxHitPoints calculates maximum hit points based on class levels
+// D&D 5e rule: Class hit die + Con modifier per level
+func (c *Character) MaxHitPoints() int {
+	conMod := Modifier(c.AbilityScore("CON"))
+
+	// Max hit die at the first level of the primary class, fixed average (half the die + 1) for every other level
+	baseHP := 0
+	for i, cl := range c.ClassLevels() {
+		hitDie := hitDieForClass(cl.Class)
+		levels := cl.Level
+		if i == 0 {
+			baseHP += hitDie
+			levels--
+		}
+		baseHP += levels * (hitDie/2 + 1)
 	}
 
 	// Add Constitution modifier for each level
 	totalHP := baseHP + (conMod * c.Level)
 
+	// Dwarven Toughness: hill dwarves gain 1 extra hit point per level
+	if raceKey(c.Race) == "hill dwarf" {
+		totalHP += c.Level
+	}
+
+	// Draconic Resilience: 1 extra hit point per sorcerer level
+	if c.HasFeature("Draconic Resilience") {
+		totalHP += c.ClassLevel("sorcerer")
+	}
+
+	// Feats such as Tough add hit points for every level
+	totalHP += c.featBonus(func(f Feat) int { return f.HitPointsPerLevel }) * c.Level
+
+	// Apply any temporary or magical adjustment to the maximum
+	totalHP += c.MaxHPAdjustment
+
 	// Minimum 1 HP per level
 	if totalHP < c.Level {
 		totalHP = c.Level
 	}
 
+	// Exhaustion level 4 halves the hit point maximum
+	if c.Exhaustion >= 4 {
+		totalHP = max(1, totalHP/2)
+	}
+
 	return totalHP
 }
 
 // Race represents a D&D 5e character race and its mechanical effects
 type Race struct {
-	Name string
+	Name       string
+	Speed      int    // walking speed in feet
+	Size       string // Small or Medium
+	Darkvision int    // range in feet, 0 if none
+	Languages  []string
+	Traits     []RacialTrait
+
+	// IgnoresHeavyArmorSpeedPenalty is true for races whose speed isn't reduced by heavy armor (dwarves)
+	IgnoresHeavyArmorSpeedPenalty bool
+
+	// Weapon and armor proficiencies granted by the race, in the same form as the class lists
+	WeaponProficiencies []string
+	ArmorProficiencies  []string
+
+	// Skill proficiencies granted by the race, such as Keen Senses
+	SkillProficiencies []string
 }
 
-// NewRace creates a new Race instance
+// NewRace creates a new Race instance with its D&D 5e racial traits
+// Unknown races fall back to a Medium creature with a 30 ft speed that speaks Common
 func NewRace(name string) *Race {
-	return &Race{Name: name}
+	r, ok := raceData[raceKey(name)]
+	if !ok {
+		r = Race{Speed: 30, Size: "Medium", Languages: []string{"Common"}}
+	}
+	r.Name = name
+	return &r
 }
 
 // GetAbilityBonuses returns the ability score bonuses for this race according to D&D 5e rules
 func (r *Race) GetAbilityBonuses() map[string]int {
 	bonuses := make(map[string]int)
 
-	switch strings.ToLower(r.Name) {
+	switch raceKey(r.Name) {
 	case "dwarf":
 		bonuses["con"] = 2
 	case "elf":
@@ -442,21 +606,73 @@ func (cl *Class) GetSkillCount() int {
 	return classSkillCount[strings.ToLower(cl.Name)]
 }
 
-// CastSpell attempts to cast a spell, consuming a spell slot of the appropriate level
+// GetSavingThrowProficiencies returns the saving throws this class is proficient in according to D&D 5e rules
+func (cl *Class) GetSavingThrowProficiencies() []string {
+	classSaves := map[string][]string{
+		"barbarian": {"STR", "CON"},
+		"bard":      {"DEX", "CHA"},
+		"cleric":    {"WIS", "CHA"},
+		"druid":     {"INT", "WIS"},
+		"fighter":   {"STR", "CON"},
+		"monk":      {"STR", "DEX"},
+		"paladin":   {"WIS", "CHA"},
+		"ranger":    {"STR", "DEX"},
+		"rogue":     {"DEX", "INT"},
+		"sorcerer":  {"CON", "CHA"},
+		"warlock":   {"WIS", "CHA"},
+		"wizard":    {"INT", "WIS"},
+	}
+
+	return classSaves[strings.ToLower(cl.Name)]
+}
+
+// CastSpell attempts to cast a spell, consuming the lowest available spell slot of the spell's level or higher
 // Returns ErrNoSpellSlot if no spell slot is available
 func (c *Character) CastSpell(spellLevel int) error {
+	_, err := c.CastSpellWithSlot(spellLevel, 0)
+	return err
+}
+
+// CastSpellWithSlot attempts to cast a spell with a spell slot of slotLevel, or with the lowest available slot of the
+// spell's level or higher if slotLevel is 0, and returns the level of the slot used
+// D&D 5e rule: a spell can be cast with a higher-level slot, which often increases its effect
+func (c *Character) CastSpellWithSlot(spellLevel, slotLevel int) (int, error) {
+	// Armor worn without proficiency prevents all spellcasting, cantrips included
+	if len(c.UnproficientArmor()) > 0 {
+		return 0, ErrArmorPreventsSpellcasting
+	}
+
 	// Cantrips (level 0) don't consume spell slots
 	if spellLevel == 0 {
-		return nil
+		return 0, nil
 	}
 
-	// Check if character has current spell slots for this level
-	currentSlots, exists := c.CurrentSpellSlots[spellLevel]
-	if !exists || currentSlots <= 0 {
-		return ErrNoSpellSlot
+	if slotLevel != 0 && slotLevel < spellLevel {
+		return 0, fmt.Errorf("%w: a level %d spell needs a slot of level %d or higher", ErrSlotTooLow, spellLevel, spellLevel)
 	}
 
-	// Consume the spell slot
-	c.CurrentSpellSlots[spellLevel]--
-	return nil
+	// Pick the lowest suitable slot; Pact Magic slots are always cast at the warlock's slot level
+	used, pact := 0, false
+	pick := func(slots map[int]int, isPact bool) {
+		for level, available := range slots {
+			if available <= 0 || level < spellLevel || (slotLevel != 0 && level != slotLevel) {
+				continue
+			}
+			if used == 0 || level < used {
+				used, pact = level, isPact
+			}
+		}
+	}
+	pick(c.CurrentSpellSlots, false)
+	pick(c.CurrentPactSlots, true)
+
+	switch {
+	case used == 0:
+		return 0, ErrNoSpellSlot
+	case pact:
+		c.CurrentPactSlots[used]--
+	default:
+		c.CurrentSpellSlots[used]--
+	}
+	return used, nil
 }
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Purse holds a character's coins (D&D 5e currency)
+type Purse struct {
+	CP int `json:"cp,omitempty"`
+	SP int `json:"sp,omitempty"`
+	EP int `json:"ep,omitempty"`
+	GP int `json:"gp,omitempty"`
+	PP int `json:"pp,omitempty"`
+}
+
+// coinValue is a coin type and its value in copper pieces
+type coinValue struct {
+	Coin  string
+	Value int
+}
+
+// coinValues lists each coin's value in copper pieces, smallest first
+var coinValues = []coinValue{
+	{"cp", 1}, {"sp", 10}, {"ep", 50}, {"gp", 100}, {"pp", 1000},
+}
+
+// coin returns a pointer to the purse's count of a coin type
+func (p *Purse) coin(name string) *int {
+	switch name {
+	case "cp":
+		return &p.CP
+	case "sp":
+		return &p.SP
+	case "ep":
+		return &p.EP
+	case "gp":
+		return &p.GP
+	case "pp":
+		return &p.PP
+	}
+	return nil
+}
+
+// ParseCoins parses an amount of money such as "15 gp", "3gp 5sp" or "2 pp, 4 cp"
+func ParseCoins(s string) (Purse, error) {
+	var purse Purse
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+	if len(fields) == 0 {
+		return purse, ErrInvalidCoins
+	}
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		// Allow both "15gp" and "15 gp"
+		digits := strings.TrimRightFunc(field, unicode.IsLetter)
+		coin := field[len(digits):]
+		if coin == "" && i+1 < len(fields) {
+			i++
+			coin = fields[i]
+		}
+
+		amount, err := strconv.Atoi(digits)
+		target := purse.coin(coin)
+		if err != nil || amount < 0 || target == nil {
+			return Purse{}, ErrInvalidCoins
+		}
+		*target += amount
+	}
+	return purse, nil
+}
+
+// TotalCopper returns the value of all coins in copper pieces
+func (p Purse) TotalCopper() int {
+	total := 0
+	for _, c := range coinValues {
+		total += *p.coin(c.Coin) * c.Value
+	}
+	return total
+}
+
+// IsEmpty checks if the purse holds no coins
+func (p Purse) IsEmpty() bool {
+	return p == Purse{}
+}
+
+// String formats the coins from the largest denomination down, e.g. "12 gp, 5 sp"
+func (p Purse) String() string {
+	var parts []string
+	for i := len(coinValues) - 1; i >= 0; i-- {
+		if n := *p.coin(coinValues[i].Coin); n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, coinValues[i].Coin))
+		}
+	}
+	if len(parts) == 0 {
+		return "0 gp"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Add puts coins into the purse
+func (p *Purse) Add(amount Purse) {
+	for _, c := range coinValues {
+		*p.coin(c.Coin) += *amount.coin(c.Coin)
+	}
+}
+
+// Pay removes coins worth the given amount, spending the smallest coins first and
+// breaking larger coins into change when needed
+func (p *Purse) Pay(cost Purse) error {
+	remaining := cost.TotalCopper()
+	if remaining > p.TotalCopper() {
+		return ErrInsufficientFunds
+	}
+
+	for i, c := range coinValues {
+		if remaining <= 0 {
+			break
+		}
+		count := p.coin(c.Coin)
+		used := min(*count, (remaining+c.Value-1)/c.Value)
+		*count -= used
+		remaining -= used * c.Value
+
+		// Overpaid with a larger coin: take the change back in smaller coins (electrum isn't given as change)
+		if remaining < 0 {
+			p.makeChange(-remaining, coinValues[:i])
+		}
+	}
+	return nil
+}
+
+// makeChange adds copper worth of change using the largest of the given coins first
+func (p *Purse) makeChange(copper int, coins []coinValue) {
+	for i := len(coins) - 1; i >= 0; i-- {
+		if coins[i].Coin == "ep" {
+			continue
+		}
+		*p.coin(coins[i].Coin) += copper / coins[i].Value
+		copper %= coins[i].Value
+	}
+}
+
+// GiveCoins adds coins to the character's purse
+func (c *Character) GiveCoins(amount Purse) {
+	c.Coins.Add(amount)
+}
+
+// PayCoins spends coins from the character's purse, converting coins as needed
+func (c *Character) PayCoins(cost Purse) error {
+	return c.Coins.Pay(cost)
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"strings"
+)
+
+// SpellCastResult describes a spell the character cast
+type SpellCastResult struct {
+	Spell              spelldomain.Spell
+	SlotLevel          int    // the level of the spell slot used, 0 for cantrips
+	EndedConcentration string // the spell the character stopped concentrating on to cast this one, empty when recasting it
+	UpcastEffect       string // the extra effect of a higher-level slot, e.g. "+2d6", empty if none is recognized
+}
+
+// Cast casts a spell with a spell slot of slotLevel, or the lowest available slot if slotLevel is 0,
+// and starts concentrating on it if the spell requires concentration
+// D&D 5e rule: casting another spell that requires concentration ends the concentration on the first one
+func (c *Character) Cast(spell spelldomain.Spell, slotLevel int) (SpellCastResult, error) {
+	result := SpellCastResult{Spell: spell}
+
+	used, err := c.CastSpellWithSlot(spell.Level, slotLevel)
+	if err != nil {
+		return result, err
+	}
+	result.SlotLevel = used
+
+	if spell.Concentration {
+		// Recasting the spell being concentrated on simply starts it again
+		if !strings.EqualFold(c.Concentration, spell.Name) {
+			result.EndedConcentration = c.Concentration
+		}
+		c.Concentration = spell.Name
+	}
+	return result, nil
+}
+
+// EndConcentration stops the character concentrating and returns the spell that ended
+func (c *Character) EndConcentration() (string, error) {
+	if c.Concentration == "" {
+		return "", ErrNotConcentrating
+	}
+	ended := c.Concentration
+	c.Concentration = ""
+	return ended, nil
+}
+
+// ConcentrationDC returns the DC of the Constitution saving throw to keep concentrating after taking damage
+// D&D 5e rule: the DC equals 10 or half the damage taken, whichever number is higher
+func ConcentrationDC(damage int) int {
+	return max(10, damage/2)
+}
+
+// ConcentrationResult describes a Constitution saving throw to keep concentrating on a spell
+type ConcentrationResult struct {
+	Spell   string
+	DC      int
+	Mode    RollMode
+	D20     int
+	Total   int
+	Success bool // the character keeps concentrating
+}
+
+// ConcentrationCheck rolls the Constitution saving throw to keep concentrating after taking damage,
+// rolling dice with roll; the spell ends on a failure
+// The War Caster feat gives advantage on the saving throw
+func (c *Character) ConcentrationCheck(damage int, roll func(sides int) int) (ConcentrationResult, error) {
+	result := ConcentrationResult{Spell: c.Concentration, DC: ConcentrationDC(damage)}
+	if c.Concentration == "" {
+		return result, ErrNotConcentrating
+	}
+	if damage < 0 {
+		return result, ErrInvalidHitPointAmount
+	}
+
+	result.Mode = c.SavingThrowMode("CON")
+	result.Mode.Advantage = result.Mode.Advantage || c.HasFeat("War Caster")
+	if !result.Mode.AutoFail {
+		result.D20 = rollD20(result.Mode, roll)
+		result.Total = result.D20 + c.SavingThrow("CON")
+		result.Success = result.Total >= result.DC
+	}
+
+	if !result.Success {
+		c.Concentration = ""
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"errors"
+	"testing"
+)
+
+func TestConcentrationDC(t *testing.T) {
+	for damage, expected := range map[int]int{1: 10, 21: 10, 22: 11, 40: 20} {
+		if got := ConcentrationDC(damage); got != expected {
+			t.Errorf("Expected DC %d for %d damage, got %d", expected, damage, got)
+		}
+	}
+}
+
+func TestCharacter_Concentration(t *testing.T) {
+	bless := spelldomain.Spell{Name: "Bless", Level: 1, Concentration: true}
+	shieldOfFaith := spelldomain.Spell{Name: "Shield of Faith", Level: 1, Concentration: true}
+	cureWounds := spelldomain.Spell{Name: "Cure Wounds", Level: 1}
+
+	cleric := NewCharacter("Mara", "human", "cleric", 3, 10, 10, 14, 10, 16, 10, "acolyte", nil)
+	if _, err := cleric.Cast(bless, 0); err != nil || cleric.Concentration != "Bless" {
+		t.Fatalf("Expected to concentrate on Bless, got %q (%v)", cleric.Concentration, err)
+	}
+
+	// A spell without concentration doesn't affect it, a second concentration spell ends the first
+	if result, _ := cleric.Cast(cureWounds, 0); result.EndedConcentration != "" || cleric.Concentration != "Bless" {
+		t.Errorf("Expected Cure Wounds to keep concentration on Bless, got %q", cleric.Concentration)
+	}
+	if result, _ := cleric.Cast(shieldOfFaith, 0); result.EndedConcentration != "Bless" || cleric.Concentration != "Shield of Faith" {
+		t.Errorf("Expected Shield of Faith to replace Bless, ended %q", result.EndedConcentration)
+	}
+	if result, _ := cleric.Cast(shieldOfFaith, 0); result.EndedConcentration != "" || cleric.Concentration != "Shield of Faith" {
+		t.Errorf("Expected recasting Shield of Faith not to end concentration, ended %q", result.EndedConcentration)
+	}
+
+	damage, _ := cleric.TakeDamage(22)
+	if damage.ConcentrationDC != 11 {
+		t.Errorf("Expected a DC 11 concentration check, got %d", damage.ConcentrationDC)
+	}
+
+	// d20 5 + CON save +2 fails against DC 11
+	result, err := cleric.ConcentrationCheck(22, fixedRoll(5))
+	if err != nil || result.Success || cleric.Concentration != "" {
+		t.Errorf("Expected to lose concentration, got %+v (%v)", result, err)
+	}
+	if _, err := cleric.ConcentrationCheck(22, fixedRoll(20)); !errors.Is(err, ErrNotConcentrating) {
+		t.Errorf("Expected ErrNotConcentrating, got %v", err)
+	}
+
+	// Dropping to 0 hit points ends concentration without a check
+	cleric.Cast(bless, 0)
+	damage, _ = cleric.TakeDamage(cleric.CurrentHP)
+	if damage.LostConcentration != "Bless" || damage.ConcentrationDC != 0 {
+		t.Errorf("Expected concentration to end at 0 HP, got %+v", damage)
+	}
+}
@@ -0,0 +1,373 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MaxExhaustion is the exhaustion level at which a character dies (D&D 5e rules)
+const MaxExhaustion = 6
+
+// ConditionExhaustion is the name used for exhaustion, which is tracked in levels rather than on or off
+const ConditionExhaustion = "exhaustion"
+
+// Condition describes one of the D&D 5e conditions and the mechanical effects the sheet applies for it
+type Condition struct {
+	Name                     string
+	Description              string
+	Incapacitated            bool     // can't take actions or reactions
+	SpeedZero                bool     // speed becomes 0
+	AttackAdvantage          bool     // advantage on the character's attack rolls
+	AttackDisadvantage       bool     // disadvantage on the character's attack rolls
+	AbilityCheckDisadvantage bool     // disadvantage on ability checks
+	SaveDisadvantage         []string // abilities whose saving throws have disadvantage
+	AutoFailSaves            []string // abilities whose saving throws fail automatically
+}
+
+// conditionCatalog contains the conditions from the D&D 5e SRD, keyed by lowercase name
+var conditionCatalog = map[string]Condition{
+	"blinded": {
+		Name:               "Blinded",
+		Description:        "Can't see and automatically fails checks that require sight. Attack rolls have disadvantage, attacks against it have advantage.",
+		AttackDisadvantage: true,
+	},
+	"charmed": {
+		Name:        "Charmed",
+		Description: "Can't attack the charmer. The charmer has advantage on social checks against it.",
+	},
+	"deafened": {
+		Name:        "Deafened",
+		Description: "Can't hear and automatically fails checks that require hearing.",
+	},
+	"frightened": {
+		Name:                     "Frightened",
+		Description:              "Disadvantage on ability checks and attack rolls while the source of fear is in sight. Can't willingly move closer to it.",
+		AttackDisadvantage:       true,
+		AbilityCheckDisadvantage: true,
+	},
+	"grappled": {
+		Name:        "Grappled",
+		Description: "Speed becomes 0.",
+		SpeedZero:   true,
+	},
+	"incapacitated": {
+		Name:          "Incapacitated",
+		Description:   "Can't take actions or reactions.",
+		Incapacitated: true,
+	},
+	"invisible": {
+		Name:            "Invisible",
+		Description:     "Impossible to see without magic. Attack rolls have advantage, attacks against it have disadvantage.",
+		AttackAdvantage: true,
+	},
+	"paralyzed": {
+		Name:          "Paralyzed",
+		Description:   "Incapacitated and can't move or speak. Automatically fails Strength and Dexterity saves. Attacks against it have advantage and hits within 5 feet are critical.",
+		Incapacitated: true,
+		SpeedZero:     true,
+		AutoFailSaves: []string{"STR", "DEX"},
+	},
+	"petrified": {
+		Name:          "Petrified",
+		Description:   "Transformed into stone and incapacitated. Automatically fails Strength and Dexterity saves, has resistance to all damage.",
+		Incapacitated: true,
+		SpeedZero:     true,
+		AutoFailSaves: []string{"STR", "DEX"},
+	},
+	"poisoned": {
+		Name:                     "Poisoned",
+		Description:              "Disadvantage on attack rolls and ability checks.",
+		AttackDisadvantage:       true,
+		AbilityCheckDisadvantage: true,
+	},
+	"prone": {
+		Name:               "Prone",
+		Description:        "Can only crawl unless it stands up. Attack rolls have disadvantage; melee attacks against it have advantage.",
+		AttackDisadvantage: true,
+	},
+	"restrained": {
+		Name:               "Restrained",
+		Description:        "Speed becomes 0. Attack rolls and Dexterity saves have disadvantage, attacks against it have advantage.",
+		SpeedZero:          true,
+		AttackDisadvantage: true,
+		SaveDisadvantage:   []string{"DEX"},
+	},
+	"stunned": {
+		Name:          "Stunned",
+		Description:   "Incapacitated, can't move and can speak only falteringly. Automatically fails Strength and Dexterity saves.",
+		Incapacitated: true,
+		SpeedZero:     true,
+		AutoFailSaves: []string{"STR", "DEX"},
+	},
+	"unconscious": {
+		Name:          "Unconscious",
+		Description:   "Incapacitated, can't move or speak and is unaware of its surroundings. Drops what it's holding, falls prone and automatically fails Strength and Dexterity saves.",
+		Incapacitated: true,
+		SpeedZero:     true,
+		AutoFailSaves: []string{"STR", "DEX"},
+	},
+}
+
+// exhaustionEffects describes each exhaustion level; the effects are cumulative
+var exhaustionEffects = []string{
+	1: "disadvantage on ability checks",
+	2: "speed halved",
+	3: "disadvantage on attack rolls and saving throws",
+	4: "hit point maximum halved",
+	5: "speed reduced to 0",
+	6: "death",
+}
+
+// LookupCondition finds a condition in the catalog by name (case-insensitive)
+func LookupCondition(name string) (Condition, bool) {
+	condition, ok := conditionCatalog[strings.ToLower(strings.TrimSpace(name))]
+	return condition, ok
+}
+
+// ConditionNames returns the names of all conditions, including exhaustion, in alphabetical order
+func ConditionNames() []string {
+	names := []string{ConditionExhaustion}
+	for name := range conditionCatalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RollMode tells whether a d20 roll has advantage, disadvantage or automatically fails
+type RollMode struct {
+	Advantage    bool
+	Disadvantage bool
+	AutoFail     bool
+}
+
+// String describes the roll mode; advantage and disadvantage cancel each other out
+func (m RollMode) String() string {
+	switch {
+	case m.AutoFail:
+		return "auto-fail"
+	case m.Advantage && !m.Disadvantage:
+		return "advantage"
+	case m.Disadvantage && !m.Advantage:
+		return "disadvantage"
+	}
+	return ""
+}
+
+// activeConditions returns the catalog entries of the character's conditions
+func (c *Character) activeConditions() []Condition {
+	var active []Condition
+	for _, name := range c.Conditions {
+		if condition, ok := LookupCondition(name); ok {
+			active = append(active, condition)
+		}
+	}
+	return active
+}
+
+// HasCondition checks if the character currently has a condition
+func (c *Character) HasCondition(name string) bool {
+	for _, active := range c.Conditions {
+		if strings.EqualFold(active, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddCondition applies a condition such as poisoned or prone
+func (c *Character) AddCondition(name string) error {
+	condition, ok := LookupCondition(name)
+	if !ok {
+		return ErrUnknownCondition
+	}
+	if c.HasCondition(condition.Name) {
+		return ErrConditionAlreadyActive
+	}
+
+	c.Conditions = append(c.Conditions, strings.ToLower(condition.Name))
+
+	// D&D 5e rule: concentration ends when the character is incapacitated
+	if condition.Incapacitated {
+		c.Concentration = ""
+	}
+	return nil
+}
+
+// RemoveCondition ends a condition
+func (c *Character) RemoveCondition(name string) error {
+	if _, ok := LookupCondition(name); !ok {
+		return ErrUnknownCondition
+	}
+	for i, active := range c.Conditions {
+		if strings.EqualFold(active, strings.TrimSpace(name)) {
+			c.Conditions = append(c.Conditions[:i], c.Conditions[i+1:]...)
+			return nil
+		}
+	}
+	return ErrConditionNotActive
+}
+
+// AddExhaustion raises the character's exhaustion level
+// D&D 5e rule: the character dies on reaching exhaustion level 6
+func (c *Character) AddExhaustion(levels int) error {
+	if levels < 1 {
+		return ErrInvalidExhaustionLevel
+	}
+	if c.Dead {
+		return ErrCharacterDead
+	}
+
+	c.Exhaustion = min(MaxExhaustion, c.Exhaustion+levels)
+	if c.Exhaustion >= MaxExhaustion {
+		c.Dead = true
+		return nil
+	}
+
+	// Exhaustion level 4 halves the hit point maximum
+	c.CurrentHP = min(c.CurrentHP, c.MaxHitPoints())
+	return nil
+}
+
+// RemoveExhaustion lowers the character's exhaustion level
+func (c *Character) RemoveExhaustion(levels int) error {
+	if levels < 1 {
+		return ErrInvalidExhaustionLevel
+	}
+	if c.Exhaustion == 0 {
+		return ErrConditionNotActive
+	}
+
+	c.Exhaustion = max(0, c.Exhaustion-levels)
+	return nil
+}
+
+// ExhaustionEffects returns the effects of the character's current exhaustion level
+func (c *Character) ExhaustionEffects() []string {
+	var effects []string
+	for level := 1; level <= c.Exhaustion && level < len(exhaustionEffects); level++ {
+		effects = append(effects, exhaustionEffects[level])
+	}
+	return effects
+}
+
+// ConditionSummary lists the active conditions with their effects, e.g. for the character sheet
+func (c *Character) ConditionSummary() []string {
+	var summary []string
+	for _, condition := range c.activeConditions() {
+		summary = append(summary, fmt.Sprintf("%s: %s", condition.Name, condition.Description))
+	}
+	if c.Exhaustion > 0 {
+		summary = append(summary, fmt.Sprintf("Exhaustion %d: %s", c.Exhaustion, strings.Join(c.ExhaustionEffects(), ", ")))
+	}
+	return summary
+}
+
+// IsIncapacitated checks if a condition prevents the character from taking actions or reactions
+func (c *Character) IsIncapacitated() bool {
+	for _, condition := range c.activeConditions() {
+		if condition.Incapacitated {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionSpeed applies conditions and exhaustion to the character's speed
+func (c *Character) conditionSpeed(speed int) int {
+	if c.Exhaustion >= 5 {
+		return 0
+	}
+	for _, condition := range c.activeConditions() {
+		if condition.SpeedZero {
+			return 0
+		}
+	}
+	if c.Exhaustion >= 2 {
+		speed /= 2
+	}
+	return speed
+}
+
+// AbilityCheckMode returns the advantage or disadvantage conditions impose on ability checks using an ability
+func (c *Character) AbilityCheckMode(ability string) RollMode {
+	ability = strings.ToUpper(ability)
+	mode := RollMode{Disadvantage: c.Exhaustion >= 1}
+
+	// Variant encumbrance: heavily encumbered characters have disadvantage on Str, Dex and Con checks
+	if c.Encumbrance() == HeavilyEncumbered && (ability == "STR" || ability == "DEX" || ability == "CON") {
+		mode.Disadvantage = true
+	}
+	// Armor without proficiency: disadvantage on Str and Dex checks
+	if len(c.UnproficientArmor()) > 0 && (ability == "STR" || ability == "DEX") {
+		mode.Disadvantage = true
+	}
+	for _, condition := range c.activeConditions() {
+		mode.Disadvantage = mode.Disadvantage || condition.AbilityCheckDisadvantage
+	}
+	return mode
+}
+
+// InitiativeMode returns the advantage or disadvantage on initiative rolls, which are Dexterity checks
+// The barbarian's Feral Instinct gives advantage on initiative
+func (c *Character) InitiativeMode() RollMode {
+	mode := c.AbilityCheckMode("DEX")
+	mode.Advantage = mode.Advantage || c.HasFeature("Feral Instinct")
+	return mode
+}
+
+// AbilityCheckSummary describes the advantage or disadvantage on ability checks, e.g. "disadvantage" when it
+// applies to every ability or "STR: disadvantage, DEX: disadvantage" when it depends on the ability
+func (c *Character) AbilityCheckSummary() string {
+	first := c.AbilityCheckMode(Abilities[0]).String()
+	var modes []string
+	same := true
+	for _, ability := range Abilities {
+		mode := c.AbilityCheckMode(ability).String()
+		same = same && mode == first
+		if mode != "" {
+			modes = append(modes, ability+": "+mode)
+		}
+	}
+	if same {
+		return first
+	}
+	return strings.Join(modes, ", ")
+}
+
+// AttackRollMode returns the advantage or disadvantage conditions impose on the character's attack rolls
+func (c *Character) AttackRollMode() RollMode {
+	// Variant encumbrance: heavily encumbered characters have disadvantage on attack rolls,
+	// and weapon attacks always involve Str or Dex, which armor without proficiency hinders
+	mode := RollMode{Disadvantage: c.Exhaustion >= 3 || c.Encumbrance() == HeavilyEncumbered || len(c.UnproficientArmor()) > 0}
+	for _, condition := range c.activeConditions() {
+		mode.Advantage = mode.Advantage || condition.AttackAdvantage
+		mode.Disadvantage = mode.Disadvantage || condition.AttackDisadvantage
+	}
+	return mode
+}
+
+// SavingThrowMode returns the advantage, disadvantage or automatic failure conditions impose on a saving throw
+func (c *Character) SavingThrowMode(ability string) RollMode {
+	ability = strings.ToUpper(ability)
+	mode := RollMode{Disadvantage: c.Exhaustion >= 3}
+
+	// Variant encumbrance: heavily encumbered characters have disadvantage on Str, Dex and Con saves
+	if c.Encumbrance() == HeavilyEncumbered && (ability == "STR" || ability == "DEX" || ability == "CON") {
+		mode.Disadvantage = true
+	}
+	// Armor without proficiency: disadvantage on Str and Dex saves
+	if len(c.UnproficientArmor()) > 0 && (ability == "STR" || ability == "DEX") {
+		mode.Disadvantage = true
+	}
+	for _, condition := range c.activeConditions() {
+		for _, a := range condition.SaveDisadvantage {
+			mode.Disadvantage = mode.Disadvantage || a == ability
+		}
+		for _, a := range condition.AutoFailSaves {
+			mode.AutoFail = mode.AutoFail || a == ability
+		}
+	}
+	return mode
+}
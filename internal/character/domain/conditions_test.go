@@ -0,0 +1,73 @@
+package domain
+
+import "testing"
+
+func TestCharacter_ExhaustionEffects(t *testing.T) {
+	char := NewCharacter("Test", "human", "fighter", 4, 15, 12, 14, 10, 10, 10, "soldier", nil)
+	maxHP := char.MaxHitPoints()
+
+	if err := char.AddExhaustion(2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Speed() != 15 || char.AbilityCheckMode("WIS").String() != "disadvantage" {
+		t.Errorf("Expected exhaustion 2 to halve speed and give disadvantage on checks, got speed %d", char.Speed())
+	}
+
+	if err := char.AddExhaustion(2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.MaxHitPoints() != maxHP/2 || char.CurrentHP != maxHP/2 {
+		t.Errorf("Expected exhaustion 4 to halve max HP to %d, got max %d current %d", maxHP/2, char.MaxHitPoints(), char.CurrentHP)
+	}
+	if char.SavingThrowMode("WIS").String() != "disadvantage" || char.AttackRollMode().String() != "disadvantage" {
+		t.Error("Expected exhaustion 3+ to give disadvantage on attacks and saves")
+	}
+
+	if _, err := char.LongRest(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Exhaustion != 3 || char.CurrentHP != maxHP {
+		t.Errorf("Expected a long rest to lower exhaustion to 3 and restore %d HP, got %d and %d", maxHP, char.Exhaustion, char.CurrentHP)
+	}
+
+	if err := char.AddExhaustion(3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !char.Dead {
+		t.Error("Expected exhaustion 6 to kill the character")
+	}
+}
+
+func TestCharacter_Conditions(t *testing.T) {
+	char := &Character{Race: "human", Class: "rogue", Level: 1}
+
+	if err := char.AddCondition("Restrained"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := char.AddCondition("restrained"); err != ErrConditionAlreadyActive {
+		t.Errorf("Expected ErrConditionAlreadyActive, got %v", err)
+	}
+	if char.Speed() != 0 || char.SavingThrowMode("DEX").String() != "disadvantage" || char.SavingThrowMode("STR").String() != "" {
+		t.Errorf("Expected restrained to set speed 0 and give disadvantage on DEX saves only")
+	}
+
+	if err := char.AddCondition("invisible"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if mode := char.AttackRollMode().String(); mode != "" {
+		t.Errorf("Expected advantage and disadvantage to cancel out, got %q", mode)
+	}
+
+	if err := char.RemoveCondition("restrained"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Speed() != 30 || char.AttackRollMode().String() != "advantage" {
+		t.Errorf("Expected speed 30 and advantage once no longer restrained, got %d", char.Speed())
+	}
+	if err := char.RemoveCondition("poisoned"); err != ErrConditionNotActive {
+		t.Errorf("Expected ErrConditionNotActive, got %v", err)
+	}
+	if err := char.AddCondition("sleepy"); err != ErrUnknownCondition {
+		t.Errorf("Expected ErrUnknownCondition, got %v", err)
+	}
+}
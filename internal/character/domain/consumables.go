@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Consumable describes an item that is used up to produce an effect
+type Consumable struct {
+	Name    string
+	Healing string // dice rolled into current hit points, e.g. "2d4+2"
+	Spell   bool   // a spell scroll casts the spell written on it, kept in the item's notes
+}
+
+// consumableCatalog contains the potions and scrolls from the D&D 5e SRD the sheet can apply, keyed by lowercase name
+var consumableCatalog = map[string]Consumable{
+	"potion of healing":          {Name: "Potion of healing", Healing: "2d4+2"},
+	"potion of greater healing":  {Name: "Potion of greater healing", Healing: "4d4+4"},
+	"potion of superior healing": {Name: "Potion of superior healing", Healing: "8d4+8"},
+	"potion of supreme healing":  {Name: "Potion of supreme healing", Healing: "10d4+20"},
+	"spell scroll":               {Name: "Spell scroll", Spell: true},
+}
+
+// LookupConsumable finds a consumable by name (case-insensitive)
+func LookupConsumable(name string) (Consumable, bool) {
+	consumable, ok := consumableCatalog[strings.ToLower(strings.TrimSpace(name))]
+	return consumable, ok
+}
+
+// ConsumableResult describes the effect of using up an item
+type ConsumableResult struct {
+	Item      string
+	Rolled    int    // the healing roll
+	Healed    int    // hit points actually regained
+	Spell     string // the spell cast from a scroll
+	Remaining int    // how many of the item are left
+}
+
+// UseConsumable uses up one of a carried potion or scroll and applies its effect, rolling dice with roll
+func (c *Character) UseConsumable(name string, roll func(sides int) int) (ConsumableResult, error) {
+	var result ConsumableResult
+
+	i := c.findItem(name)
+	if i < 0 {
+		return result, ErrItemNotCarried
+	}
+	item := c.Inventory[i]
+	consumable, ok := LookupConsumable(item.Name)
+	if !ok {
+		return result, fmt.Errorf("%w: %s", ErrNotConsumable, item.Name)
+	}
+	if c.Dead {
+		return result, ErrCharacterDead
+	}
+
+	result.Item = item.Name
+	if consumable.Spell {
+		// The scroll crumbles to dust once the spell is cast, whatever its level
+		if item.Notes == "" {
+			return result, fmt.Errorf("%w: the scroll has no spell noted on it", ErrNotConsumable)
+		}
+		result.Spell = item.Notes
+	}
+	if consumable.Healing != "" {
+		rolled, err := RollDice(consumable.Healing, false, roll)
+		if err != nil {
+			return result, err
+		}
+		healed, err := c.Heal(rolled)
+		if err != nil {
+			return result, err
+		}
+		result.Rolled, result.Healed = rolled, healed
+	}
+
+	left, err := c.RemoveItem(item.Name, 1)
+	if err != nil {
+		return result, err
+	}
+	result.Remaining = left.Quantity
+	return result, nil
+}
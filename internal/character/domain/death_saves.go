@@ -0,0 +1,99 @@
+package domain
+
+// LifeState describes whether a character is up and about, dying, stable or dead
+type LifeState string
+
+// Life states (D&D 5e rules for dropping to 0 hit points)
+const (
+	LifeStateConscious LifeState = "conscious"
+	LifeStateDying     LifeState = "dying"
+	LifeStateStable    LifeState = "stable"
+	LifeStateDead      LifeState = "dead"
+)
+
+// deathSavesNeeded is the number of successes (or failures) that ends the dying state
+const deathSavesNeeded = 3
+
+// DeathSaves tracks death saving throws made while a character is at 0 hit points
+type DeathSaves struct {
+	Successes int  `json:"successes"`
+	Failures  int  `json:"failures"`
+	Stable    bool `json:"stable,omitempty"`
+}
+
+// DeathSaveResult describes the outcome of a single death saving throw
+type DeathSaveResult struct {
+	Roll     int
+	Success  bool
+	Critical bool // natural 1 or natural 20
+	State    LifeState
+}
+
+// LifeState returns the character's current state derived from hit points and death saves
+func (c *Character) LifeState() LifeState {
+	switch {
+	case c.Dead:
+		return LifeStateDead
+	case c.CurrentHP > 0:
+		return LifeStateConscious
+	case c.DeathSaves.Stable:
+		return LifeStateStable
+	default:
+		return LifeStateDying
+	}
+}
+
+// RollDeathSave records a death saving throw for a dying character
+// D&D 5e rule: 10 or higher succeeds, a natural 20 regains 1 hit point,
+// a natural 1 counts as two failures; three successes stabilise, three failures kill
+func (c *Character) RollDeathSave(roll int) (DeathSaveResult, error) {
+	result := DeathSaveResult{Roll: roll}
+
+	if roll < 1 || roll > 20 {
+		return result, ErrInvalidDeathSaveRoll
+	}
+	if c.LifeState() != LifeStateDying {
+		return result, ErrNotDying
+	}
+
+	switch {
+	case roll == 20:
+		result.Success = true
+		result.Critical = true
+		c.CurrentHP = 1
+		c.DeathSaves = DeathSaves{}
+	case roll == 1:
+		result.Critical = true
+		c.addDeathSaveFailures(2)
+	case roll >= 10:
+		result.Success = true
+		c.DeathSaves.Successes++
+		if c.DeathSaves.Successes >= deathSavesNeeded {
+			c.Stabilize()
+		}
+	default:
+		c.addDeathSaveFailures(1)
+	}
+
+	result.State = c.LifeState()
+	return result, nil
+}
+
+// Stabilize makes a dying character stable, e.g. after a successful Medicine check or Spare the Dying
+func (c *Character) Stabilize() error {
+	if c.LifeState() != LifeStateDying {
+		return ErrNotDying
+	}
+	c.DeathSaves = DeathSaves{Stable: true}
+	return nil
+}
+
+// addDeathSaveFailures records failed death saves; a stable character starts dying again
+func (c *Character) addDeathSaveFailures(n int) {
+	c.DeathSaves.Stable = false
+	c.DeathSaves.Failures += n
+	if c.DeathSaves.Failures >= deathSavesNeeded {
+		c.DeathSaves.Failures = deathSavesNeeded
+		c.Dead = true
+	}
+}
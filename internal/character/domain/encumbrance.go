@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"math"
+	"strings"
+)
+
+// coinsPerPound is how many coins weigh one pound (D&D 5e rules)
+const coinsPerPound = 50
+
+// EncumbranceStatus describes how weighed down a character is
+type EncumbranceStatus string
+
+// Encumbrance statuses; the encumbered levels only apply with the variant encumbrance rule
+const (
+	Unencumbered      EncumbranceStatus = "unencumbered"
+	Encumbered        EncumbranceStatus = "encumbered"
+	HeavilyEncumbered EncumbranceStatus = "heavily encumbered"
+	OverCapacity      EncumbranceStatus = "over carrying capacity"
+)
+
+// sizeCapacityMultiplier returns how a creature's size scales its carrying capacity
+// D&D 5e rule: double for each size category above Medium, halved for Tiny
+func sizeCapacityMultiplier(size string) float64 {
+	switch strings.ToLower(size) {
+	case "tiny":
+		return 0.5
+	case "large":
+		return 2
+	case "huge":
+		return 4
+	case "gargantuan":
+		return 8
+	}
+	return 1
+}
+
+// CarryingCapacity returns the weight in pounds the character can carry (Str x 15, adjusted for size)
+func (c *Character) CarryingCapacity() float64 {
+	return float64(c.AbilityScore("STR")*15) * sizeCapacityMultiplier(NewRace(c.Race).Size)
+}
+
+// PushDragLift returns the weight in pounds the character can push, drag or lift (twice the carrying capacity)
+func (c *Character) PushDragLift() float64 {
+	return c.CarryingCapacity() * 2
+}
+
+// EncumbranceThresholds returns the variant encumbrance thresholds in pounds (Str x 5 and Str x 10, adjusted for size)
+func (c *Character) EncumbranceThresholds() (encumbered, heavilyEncumbered float64) {
+	multiplier := sizeCapacityMultiplier(NewRace(c.Race).Size)
+	str := c.AbilityScore("STR")
+	return float64(str*5) * multiplier, float64(str*10) * multiplier
+}
+
+// CarriedWeight returns the total weight of the inventory and coins in pounds
+func (c *Character) CarriedWeight() float64 {
+	total := 0.0
+	for _, item := range c.Inventory {
+		total += item.Weight * float64(item.Quantity)
+	}
+
+	coins := c.Coins.CP + c.Coins.SP + c.Coins.EP + c.Coins.GP + c.Coins.PP
+	total += float64(coins) / coinsPerPound
+
+	// Round away floating point noise from fractional weights such as 0.075 lb crossbow bolts
+	return math.Round(total*100) / 100
+}
+
+// Encumbrance returns the character's encumbrance status
+// Without the variant rule only exceeding the carrying capacity matters
+func (c *Character) Encumbrance() EncumbranceStatus {
+	weight := c.CarriedWeight()
+	if weight > c.CarryingCapacity() {
+		return OverCapacity
+	}
+	if !c.VariantEncumbrance {
+		return Unencumbered
+	}
+
+	encumbered, heavilyEncumbered := c.EncumbranceThresholds()
+	switch {
+	case weight > heavilyEncumbered:
+		return HeavilyEncumbered
+	case weight > encumbered:
+		return Encumbered
+	}
+	return Unencumbered
+}
+
+// encumbranceSpeed applies the speed penalty for carrying too much
+// D&D 5e rule: beyond the carrying capacity speed drops to 5 feet; the variant rule
+// reduces speed by 10 feet when encumbered and 20 feet when heavily encumbered
+func (c *Character) encumbranceSpeed(speed int) int {
+	switch c.Encumbrance() {
+	case OverCapacity:
+		return min(speed, 5)
+	case HeavilyEncumbered:
+		return max(0, speed-20)
+	case Encumbered:
+		return max(0, speed-10)
+	}
+	return speed
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "testing"
+
+func TestCharacter_Encumbrance(t *testing.T) {
+	char := &Character{Race: "human", Class: "fighter", Level: 1, Str: 10}
+	char.AddItem(InventoryItem{Name: "Chain Mail", Quantity: 1, Weight: 55})
+
+	if char.CarryingCapacity() != 150 || char.PushDragLift() != 300 {
+		t.Errorf("Expected capacity 150 and push/drag/lift 300, got %g and %g", char.CarryingCapacity(), char.PushDragLift())
+	}
+	if char.Encumbrance() != Unencumbered || char.Speed() != 30 {
+		t.Errorf("Expected 55 lb to be fine without the variant rule, got %s at speed %d", char.Encumbrance(), char.Speed())
+	}
+
+	char.VariantEncumbrance = true
+	if char.Encumbrance() != Encumbered || char.Speed() != 20 {
+		t.Errorf("Expected 55 lb to encumber Str 10 (speed 20), got %s at speed %d", char.Encumbrance(), char.Speed())
+	}
+
+	char.AddItem(InventoryItem{Name: "Crossbow bolt", Quantity: 20, Weight: 0.075})
+	char.GiveCoins(Purse{GP: 50})
+	if weight := char.CarriedWeight(); weight != 57.5 {
+		t.Errorf("Expected 57.5 lb including bolts and coins, got %g", weight)
+	}
+
+	char.AddItem(InventoryItem{Name: "Plate Armor", Quantity: 1, Weight: 65})
+	if char.Encumbrance() != HeavilyEncumbered || char.Speed() != 10 || char.SavingThrowMode("CON").String() != "disadvantage" {
+		t.Errorf("Expected heavily encumbered at speed 10 with disadvantage on CON saves, got %s at speed %d", char.Encumbrance(), char.Speed())
+	}
+	if char.AbilityCheckMode("STR").String() != "disadvantage" || char.AbilityCheckMode("INT").String() != "" {
+		t.Errorf("Expected disadvantage on STR checks only, got STR %q and INT %q", char.AbilityCheckMode("STR"), char.AbilityCheckMode("INT"))
+	}
+	if summary := char.AbilityCheckSummary(); summary != "STR: disadvantage, DEX: disadvantage, CON: disadvantage" {
+		t.Errorf("Unexpected ability check summary %q", summary)
+	}
+
+	char.AddItem(InventoryItem{Name: "Barrel", Quantity: 1, Weight: 70})
+	if char.Encumbrance() != OverCapacity || char.Speed() != 5 {
+		t.Errorf("Expected speed 5 over carrying capacity, got %s at speed %d", char.Encumbrance(), char.Speed())
+	}
+}
@@ -7,4 +7,185 @@ import "errors"
 var (
 	// ErrNoSpellSlot indicates a spell slot is not available for casting
 	ErrNoSpellSlot = errors.New("spell slot not available")
+
+	// ErrCharacterDead indicates the character is dead and can't be affected
+	ErrCharacterDead = errors.New("character is dead")
+
+	// ErrInvalidHitPointAmount indicates a negative damage, healing or temporary HP amount
+	ErrInvalidHitPointAmount = errors.New("hit point amount must not be negative")
+
+	// ErrNoHitDice indicates the character has no hit dice left to spend
+	ErrNoHitDice = errors.New("no hit dice remaining")
+
+	// ErrNotDying indicates a death save or stabilisation was attempted on a character who isn't dying
+	ErrNotDying = errors.New("character is not dying")
+
+	// ErrInvalidDeathSaveRoll indicates a death save roll outside 1-20
+	ErrInvalidDeathSaveRoll = errors.New("death save roll must be between 1 and 20")
+
+	// ErrRestRequiresHitPoints indicates a long rest was started at 0 hit points
+	ErrRestRequiresHitPoints = errors.New("at least 1 hit point is needed to benefit from a long rest")
+
+	// ErrInvalidLevel indicates a class level below 1 or a total character level above 20
+	ErrInvalidLevel = errors.New("level must be between 1 and 20")
+
+	// ErrMulticlassPrerequisites indicates the character lacks the ability scores needed to multiclass
+	ErrMulticlassPrerequisites = errors.New("multiclass prerequisites not met")
+
+	// ErrNoPendingASI indicates there is no Ability Score Improvement left to spend
+	ErrNoPendingASI = errors.New("no ability score improvement pending")
+
+	// ErrInvalidAbilityScoreImprovement indicates an ASI that isn't +2 to one ability or +1 to two different abilities
+	ErrInvalidAbilityScoreImprovement = errors.New("choose one ability for +2 or two different abilities for +1")
+
+	// ErrAbilityScoreCap indicates an improvement would raise an ability score above 20
+	ErrAbilityScoreCap = errors.New("ability scores can't be raised above 20")
+
+	// ErrUnknownFeat indicates a feat that isn't in the feat catalog
+	ErrUnknownFeat = errors.New("unknown feat")
+
+	// ErrFeatAlreadyTaken indicates the character already has the feat
+	ErrFeatAlreadyTaken = errors.New("feat already taken")
+
+	// ErrInvalidAbilityChoice indicates an ability the feat can't increase
+	ErrInvalidAbilityChoice = errors.New("the feat can't increase that ability")
+
+	// ErrClassNotTaken indicates an operation on a class the character has no levels in
+	ErrClassNotTaken = errors.New("character has no levels in that class")
+
+	// ErrUnknownSubclass indicates a subclass that isn't in the feature catalog for the class
+	ErrUnknownSubclass = errors.New("unknown subclass")
+
+	// ErrSubclassAlreadyChosen indicates the class already has a subclass
+	ErrSubclassAlreadyChosen = errors.New("subclass already chosen")
+
+	// ErrSubclassLevelTooLow indicates the class isn't high enough level to choose a subclass
+	ErrSubclassLevelTooLow = errors.New("class level too low to choose a subclass")
+
+	// ErrNoFightingStyleFeature indicates the character doesn't have the Fighting Style feature
+	ErrNoFightingStyleFeature = errors.New("character doesn't have the Fighting Style feature")
+
+	// ErrUnknownFightingStyle indicates a Fighting Style that doesn't exist
+	ErrUnknownFightingStyle = errors.New("unknown fighting style")
+
+	// ErrUnknownResource indicates a limited-use resource the character doesn't have
+	ErrUnknownResource = errors.New("unknown resource")
+
+	// ErrResourceDepleted indicates a resource doesn't have enough uses left
+	ErrResourceDepleted = errors.New("not enough uses of the resource left")
+
+	// ErrInvalidResourceAmount indicates a negative or zero number of resource uses
+	ErrInvalidResourceAmount = errors.New("invalid resource amount")
+
+	// ErrUnknownCondition indicates a condition that isn't one of the D&D 5e conditions
+	ErrUnknownCondition = errors.New("unknown condition")
+
+	// ErrConditionAlreadyActive indicates the character already has the condition
+	ErrConditionAlreadyActive = errors.New("condition already active")
+
+	// ErrConditionNotActive indicates the character doesn't have the condition being removed
+	ErrConditionNotActive = errors.New("condition not active")
+
+	// ErrInvalidExhaustionLevel indicates a non-positive number of exhaustion levels
+	ErrInvalidExhaustionLevel = errors.New("exhaustion levels must be at least 1")
+
+	// ErrUnknownItem indicates an item that isn't in the equipment catalog
+	ErrUnknownItem = errors.New("unknown item")
+
+	// ErrItemNotCarried indicates an item that isn't in the character's inventory
+	ErrItemNotCarried = errors.New("item not in inventory")
+
+	// ErrInvalidItemQuantity indicates a non-positive quantity or more items than the character carries
+	ErrInvalidItemQuantity = errors.New("invalid item quantity")
+
+	// ErrInvalidCoins indicates an amount of money that can't be parsed, e.g. "ten gold"
+	ErrInvalidCoins = errors.New("invalid amount of coins")
+
+	// ErrInsufficientFunds indicates the character can't afford a payment
+	ErrInsufficientFunds = errors.New("not enough money")
+
+	// ErrUnknownArmor indicates armor or a shield that isn't in the equipment catalog
+	ErrUnknownArmor = errors.New("unknown armor")
+
+	// ErrUnknownWeapon indicates a weapon that isn't in the equipment catalog
+	ErrUnknownWeapon = errors.New("unknown weapon")
+
+	// ErrSlotOccupied indicates an equipment slot that already holds something
+	ErrSlotOccupied = errors.New("already occupied")
+
+	// ErrInvalidSlot indicates an equipment slot other than main hand, off hand, armor or shield
+	ErrInvalidSlot = errors.New("invalid equipment slot")
+
+	// ErrSlotEmpty indicates unequipping a slot that holds nothing
+	ErrSlotEmpty = errors.New("nothing equipped in that slot")
+
+	// ErrHandsFull indicates a two-handed weapon combined with an off-hand weapon or a shield
+	ErrHandsFull = errors.New("a two-handed weapon needs both hands")
+
+	// ErrNotLightWeapon indicates two-weapon fighting with a weapon that isn't light
+	ErrNotLightWeapon = errors.New("two-weapon fighting needs light weapons")
+
+	// ErrNotProficient indicates equipment the character isn't proficient with, when proficiency is enforced
+	ErrNotProficient = errors.New("not proficient")
+
+	// ErrArmorPreventsSpellcasting indicates a character wearing armor without proficiency trying to cast a spell
+	ErrArmorPreventsSpellcasting = errors.New("can't cast spells while wearing armor without proficiency")
+
+	// ErrIncapacitated indicates an incapacitated character trying to take an action
+	ErrIncapacitated = errors.New("can't take actions while incapacitated")
+
+	// ErrOutOfAmmunition indicates an attack with an ammunition weapon and none of its ammunition left
+	ErrOutOfAmmunition = errors.New("out of ammunition")
+
+	// ErrInvalidDice indicates a dice expression that can't be rolled
+	ErrInvalidDice = errors.New("invalid dice expression")
+
+	// ErrNotConsumable indicates using an item that isn't a potion or scroll
+	ErrNotConsumable = errors.New("item can't be used up")
+
+	// ErrNoAttunement indicates attuning to an item that isn't a magic item requiring attunement
+	ErrNoAttunement = errors.New("item doesn't require attunement")
+
+	// ErrAlreadyAttuned indicates attuning to an item the character is already attuned to
+	ErrAlreadyAttuned = errors.New("already attuned")
+
+	// ErrAttunementFull indicates attuning to a fourth magic item
+	ErrAttunementFull = errors.New("already attuned to three magic items")
+
+	// ErrAttunementRestricted indicates attuning to an item that requires a different class
+	ErrAttunementRestricted = errors.New("can't attune to that item")
+
+	// ErrNotAttuned indicates ending attunement to an item the character isn't attuned to
+	ErrNotAttuned = errors.New("not attuned")
+
+	// ErrSpellNotOnClassList indicates learning or preparing a spell from another class's spell list
+	ErrSpellNotOnClassList = errors.New("spell is not on the class spell list")
+
+	// ErrSpellLevelTooHigh indicates learning or preparing a spell of a level the character has no slots for
+	ErrSpellLevelTooHigh = errors.New("the spell has higher level than the available spell slots")
+
+	// ErrTooManySpellsKnown indicates learning a spell when the character already knows as many as the class allows
+	ErrTooManySpellsKnown = errors.New("spells known limit reached")
+
+	// ErrTooManySpellsPrepared indicates preparing a spell when the character already has as many prepared as allowed
+	ErrTooManySpellsPrepared = errors.New("prepared spells limit reached")
+
+	// ErrTooManyCantrips indicates learning or preparing a cantrip when the character already knows as many as allowed
+	ErrTooManyCantrips = errors.New("cantrips known limit reached")
+
+	// ErrSpellNotKnown indicates forgetting a spell the character doesn't know
+	ErrSpellNotKnown = errors.New("spell not known")
+
+	// ErrNotConcentrating indicates a concentration check or ending concentration while not concentrating on a spell
+	ErrNotConcentrating = errors.New("not concentrating on a spell")
+
+	// ErrSlotTooLow indicates casting a spell with a spell slot below the spell's level
+	ErrSlotTooLow = errors.New("spell slot level too low")
+
+	// ErrNoPowerAttack indicates a -5/+10 power attack without Great Weapon Master for a heavy melee weapon or
+	// Sharpshooter for a ranged weapon, or with a weapon the character isn't proficient with
+	ErrNoPowerAttack = errors.New("no feat allows a power attack with this weapon")
+
+	// ErrSpellNotPrepared indicates unpreparing a spell the character hasn't prepared
+	ErrSpellNotPrepared = errors.New("spell not prepared")
 )
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Feat describes a D&D 5e feat and the mechanical effects the character sheet applies for it
+type Feat struct {
+	Name        string
+	Description string
+
+	// AbilityChoices lists the abilities the feat can increase by 1; empty if it gives no increase
+	AbilityChoices []string
+	// SaveProficiency grants proficiency in saving throws of the chosen ability (Resilient)
+	SaveProficiency bool
+
+	InitiativeBonus        int
+	SpeedBonus             int
+	HitPointsPerLevel      int
+	PassivePerceptionBonus int
+}
+
+// CharacterFeat records a feat a character has taken and the ability chosen for it, if any
+type CharacterFeat struct {
+	Name    string `json:"name"`
+	Ability string `json:"ability,omitempty"`
+}
+
+// String formats the feat with its chosen ability, e.g. "Resilient (CON)"
+func (f CharacterFeat) String() string {
+	if f.Ability == "" {
+		return f.Name
+	}
+	return f.Name + " (" + f.Ability + ")"
+}
+
+// featCatalog holds the feats that can be taken instead of an Ability Score Improvement, keyed by lowercase name
+var featCatalog = map[string]Feat{
+	"actor": {
+		Name:           "Actor",
+		Description:    "Advantage on Deception and Performance checks when passing yourself off as someone else; mimic speech and sounds",
+		AbilityChoices: []string{"CHA"},
+	},
+	"alert": {
+		Name:            "Alert",
+		Description:     "+5 to initiative, can't be surprised while conscious, hidden attackers gain no advantage against you",
+		InitiativeBonus: 5,
+	},
+	"athlete": {
+		Name:           "Athlete",
+		Description:    "Standing up from prone and climbing cost less movement; running jumps need only a 5 ft run-up",
+		AbilityChoices: []string{"STR", "DEX"},
+	},
+	"durable": {
+		Name:           "Durable",
+		Description:    "When you spend a hit die, regain at least twice your Constitution modifier",
+		AbilityChoices: []string{"CON"},
+	},
+	"great weapon master": {
+		Name:        "Great Weapon Master",
+		Description: "Bonus action attack after a critical hit or kill; take -5 to hit with a heavy weapon for +10 damage",
+	},
+	"keen mind": {
+		Name:           "Keen Mind",
+		Description:    "Always know north and the hours until sunrise or sunset; recall anything seen or heard within the past month",
+		AbilityChoices: []string{"INT"},
+	},
+	"lucky": {
+		Name:        "Lucky",
+		Description: "3 luck points per long rest to reroll an attack roll, ability check or saving throw",
+	},
+	"mobile": {
+		Name:        "Mobile",
+		Description: "+10 ft speed; Dash ignores difficult terrain; no opportunity attacks from creatures you attacked",
+		SpeedBonus:  10,
+	},
+	"observant": {
+		Name:                   "Observant",
+		Description:            "Read lips; +5 to passive Perception and passive Investigation",
+		AbilityChoices:         []string{"INT", "WIS"},
+		PassivePerceptionBonus: 5,
+	},
+	"resilient": {
+		Name:            "Resilient",
+		Description:     "Proficiency in saving throws using the chosen ability",
+		AbilityChoices:  Abilities,
+		SaveProficiency: true,
+	},
+	"sharpshooter": {
+		Name:        "Sharpshooter",
+		Description: "Long range doesn't impose disadvantage; ignore half and three-quarters cover; -5 to hit for +10 damage",
+	},
+	"tough": {
+		Name:              "Tough",
+		Description:       "Hit point maximum increases by 2 for every level",
+		HitPointsPerLevel: 2,
+	},
+	"war caster": {
+		Name:        "War Caster",
+		Description: "Advantage on concentration saves; cast spells with hands full and as opportunity attacks",
+	},
+}
+
+// LookupFeat finds a feat in the catalog by name (case-insensitive)
+func LookupFeat(name string) (Feat, bool) {
+	feat, ok := featCatalog[strings.ToLower(strings.TrimSpace(name))]
+	return feat, ok
+}
+
+// FeatNames returns the names of all feats in the catalog in alphabetical order
+func FeatNames() []string {
+	names := make([]string, 0, len(featCatalog))
+	for _, feat := range featCatalog {
+		names = append(names, feat.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TakeFeat spends a pending Ability Score Improvement on a feat
+// ability picks which ability the feat increases when it offers a choice; it can be empty if there is only one option
+// D&D 5e rule: a feat can only be taken once, and its ability increase can't raise a score above 20
+func (c *Character) TakeFeat(name, ability string) error {
+	if c.PendingASI <= 0 {
+		return ErrNoPendingASI
+	}
+
+	feat, ok := LookupFeat(name)
+	if !ok {
+		return ErrUnknownFeat
+	}
+	for _, taken := range c.Feats {
+		if strings.EqualFold(taken.Name, feat.Name) {
+			return ErrFeatAlreadyTaken
+		}
+	}
+
+	chosen := CharacterFeat{Name: feat.Name}
+	if len(feat.AbilityChoices) > 0 {
+		if ability == "" && len(feat.AbilityChoices) == 1 {
+			ability = feat.AbilityChoices[0]
+		}
+		for _, choice := range feat.AbilityChoices {
+			if strings.EqualFold(choice, ability) {
+				chosen.Ability = choice
+			}
+		}
+		if chosen.Ability == "" {
+			return ErrInvalidAbilityChoice
+		}
+	}
+
+	oldMaxHP := c.MaxHitPoints()
+	if chosen.Ability != "" && c.BaseAbilityScore(chosen.Ability) < MaxAbilityScore {
+		c.adjustAbilityScore(chosen.Ability, 1)
+	}
+	c.Feats = append(c.Feats, chosen)
+	c.PendingASI--
+	c.applyMaxHitPointChange(oldMaxHP)
+	c.RefreshResources()
+
+	return nil
+}
+
+// HasFeat checks if the character has taken a feat
+func (c *Character) HasFeat(name string) bool {
+	for _, taken := range c.Feats {
+		if strings.EqualFold(taken.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// featBonus sums a numeric effect over all feats the character has taken
+func (c *Character) featBonus(effect func(Feat) int) int {
+	total := 0
+	for _, taken := range c.Feats {
+		if feat, ok := LookupFeat(taken.Name); ok {
+			total += effect(feat)
+		}
+	}
+	return total
+}
+
+// hasFeatSaveProficiency checks if a feat such as Resilient grants proficiency in an ability's saves
+func (c *Character) hasFeatSaveProficiency(ability string) bool {
+	for _, taken := range c.Feats {
+		feat, ok := LookupFeat(taken.Name)
+		if ok && feat.SaveProficiency && strings.EqualFold(taken.Ability, ability) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,370 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ClassFeature is a class or subclass feature gained at a given class level
+type ClassFeature struct {
+	Class       string
+	Subclass    string // empty for features every member of the class gets
+	Level       int
+	Name        string
+	Description string
+
+	// ArmorProficiencies lists the armor categories the feature grants proficiency with, e.g. "heavy"
+	ArmorProficiencies []string
+}
+
+// String formats the feature with where it comes from, e.g. "Second Wind (fighter 1)"
+func (f ClassFeature) String() string {
+	return fmt.Sprintf("%s (%s %d)", f.Name, f.Class, f.Level)
+}
+
+// subclassLevels is the class level at which each class picks its subclass
+// Classes not listed choose at 3rd level
+var subclassLevels = map[string]int{
+	"cleric":   1,
+	"sorcerer": 1,
+	"warlock":  1,
+	"druid":    2,
+	"wizard":   2,
+}
+
+// SubclassLevel returns the class level at which a class chooses its subclass
+func SubclassLevel(class string) int {
+	if level, ok := subclassLevels[strings.ToLower(class)]; ok {
+		return level
+	}
+	return 3
+}
+
+// featureCatalog lists the D&D 5e SRD class and subclass features
+var featureCatalog = []ClassFeature{
+	// Barbarian
+	{Class: "barbarian", Level: 1, Name: "Rage", Description: "Bonus action: advantage on Str checks and saves, bonus melee damage, resistance to bludgeoning, piercing and slashing damage"},
+	{Class: "barbarian", Level: 1, Name: "Unarmored Defense", Description: "Without armor, AC equals 10 + Dex modifier + Con modifier"},
+	{Class: "barbarian", Level: 2, Name: "Reckless Attack", Description: "Gain advantage on Str melee attacks this turn; attacks against you have advantage until your next turn"},
+	{Class: "barbarian", Level: 2, Name: "Danger Sense", Description: "Advantage on Dex saves against effects you can see"},
+	{Class: "barbarian", Level: 5, Name: "Extra Attack", Description: "Attack twice when you take the Attack action"},
+	{Class: "barbarian", Level: 5, Name: "Fast Movement", Description: "+10 ft speed while not wearing heavy armor"},
+	{Class: "barbarian", Level: 7, Name: "Feral Instinct", Description: "Advantage on initiative; act while surprised if you rage first"},
+	{Class: "barbarian", Level: 9, Name: "Brutal Critical", Description: "Roll one additional weapon damage die on a melee critical hit"},
+	{Class: "barbarian", Level: 11, Name: "Relentless Rage", Description: "Drop to 1 HP instead of 0 while raging on a successful Con save"},
+	{Class: "barbarian", Level: 15, Name: "Persistent Rage", Description: "Rage only ends early if you fall unconscious or choose to end it"},
+	{Class: "barbarian", Level: 18, Name: "Indomitable Might", Description: "Use your Str score in place of a lower Str check total"},
+	{Class: "barbarian", Level: 20, Name: "Primal Champion", Description: "Str and Con increase by 4, to a maximum of 24"},
+	{Class: "barbarian", Subclass: "Path of the Berserker", Level: 3, Name: "Frenzy", Description: "Make a bonus action melee attack each turn while raging; gain exhaustion when it ends"},
+	{Class: "barbarian", Subclass: "Path of the Berserker", Level: 6, Name: "Mindless Rage", Description: "Can't be charmed or frightened while raging"},
+	{Class: "barbarian", Subclass: "Path of the Berserker", Level: 10, Name: "Intimidating Presence", Description: "Action: frighten a creature within 30 ft"},
+	{Class: "barbarian", Subclass: "Path of the Berserker", Level: 14, Name: "Retaliation", Description: "Reaction: melee attack a creature within 5 ft that damages you"},
+
+	// Bard
+	{Class: "bard", Level: 1, Name: "Spellcasting", Description: "Cast bard spells using Charisma"},
+	{Class: "bard", Level: 1, Name: "Bardic Inspiration", Description: "Bonus action: give a creature an inspiration die to add to a check, attack or save"},
+	{Class: "bard", Level: 2, Name: "Jack of All Trades", Description: "Add half your proficiency bonus to ability checks you aren't proficient in"},
+	{Class: "bard", Level: 2, Name: "Song of Rest", Description: "Allies regain extra hit points when spending hit dice during a short rest"},
+	{Class: "bard", Level: 3, Name: "Expertise", Description: "Double proficiency bonus for two chosen skill proficiencies"},
+	{Class: "bard", Level: 5, Name: "Font of Inspiration", Description: "Regain Bardic Inspiration on a short or long rest"},
+	{Class: "bard", Level: 6, Name: "Countercharm", Description: "Action: allies within 30 ft have advantage on saves against being frightened or charmed"},
+	{Class: "bard", Level: 10, Name: "Magical Secrets", Description: "Learn two spells from any class"},
+	{Class: "bard", Level: 20, Name: "Superior Inspiration", Description: "Regain one use of Bardic Inspiration when rolling initiative with none left"},
+	{Class: "bard", Subclass: "College of Lore", Level: 3, Name: "Bonus Proficiencies", Description: "Gain proficiency with three skills of your choice"},
+	{Class: "bard", Subclass: "College of Lore", Level: 3, Name: "Cutting Words", Description: "Reaction: spend Bardic Inspiration to reduce a creature's roll"},
+	{Class: "bard", Subclass: "College of Lore", Level: 6, Name: "Additional Magical Secrets", Description: "Learn two spells from any class"},
+	{Class: "bard", Subclass: "College of Lore", Level: 14, Name: "Peerless Skill", Description: "Spend Bardic Inspiration on your own ability checks"},
+
+	// Cleric
+	{Class: "cleric", Level: 1, Name: "Spellcasting", Description: "Prepare and cast cleric spells using Wisdom"},
+	{Class: "cleric", Level: 2, Name: "Channel Divinity", Description: "Channel divine energy, e.g. Turn Undead"},
+	{Class: "cleric", Level: 5, Name: "Destroy Undead", Description: "Turned undead of low challenge rating are destroyed"},
+	{Class: "cleric", Level: 10, Name: "Divine Intervention", Description: "Call on your deity to intervene"},
+	{Class: "cleric", Subclass: "Life Domain", Level: 1, Name: "Bonus Proficiency", Description: "Proficiency with heavy armor", ArmorProficiencies: []string{"heavy"}},
+	{Class: "cleric", Subclass: "Life Domain", Level: 1, Name: "Disciple of Life", Description: "Healing spells restore an additional 2 + spell level hit points"},
+	{Class: "cleric", Subclass: "Life Domain", Level: 2, Name: "Channel Divinity: Preserve Life", Description: "Restore hit points equal to five times your cleric level, split among creatures"},
+	{Class: "cleric", Subclass: "Life Domain", Level: 6, Name: "Blessed Healer", Description: "Regain 2 + spell level hit points when healing others"},
+	{Class: "cleric", Subclass: "Life Domain", Level: 8, Name: "Divine Strike", Description: "Once per turn, +1d8 radiant damage on a weapon hit"},
+	{Class: "cleric", Subclass: "Life Domain", Level: 17, Name: "Supreme Healing", Description: "Use the maximum roll for healing dice"},
+
+	// Druid
+	{Class: "druid", Level: 1, Name: "Druidic", Description: "Know the secret language of druids"},
+	{Class: "druid", Level: 1, Name: "Spellcasting", Description: "Prepare and cast druid spells using Wisdom"},
+	{Class: "druid", Level: 2, Name: "Wild Shape", Description: "Magically assume the shape of a beast you have seen"},
+	{Class: "druid", Level: 18, Name: "Timeless Body", Description: "Age only one year for every ten that pass"},
+	{Class: "druid", Level: 18, Name: "Beast Spells", Description: "Cast spells while in Wild Shape"},
+	{Class: "druid", Level: 20, Name: "Archdruid", Description: "Use Wild Shape an unlimited number of times"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 2, Name: "Bonus Cantrip", Description: "Learn one additional druid cantrip"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 2, Name: "Natural Recovery", Description: "Recover spell slots during a short rest once per day"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 3, Name: "Circle Spells", Description: "Always have spells tied to your chosen land prepared"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 6, Name: "Land's Stride", Description: "Nonmagical difficult terrain costs no extra movement"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 10, Name: "Nature's Ward", Description: "Immune to poison and disease; can't be charmed or frightened by elementals or fey"},
+	{Class: "druid", Subclass: "Circle of the Land", Level: 14, Name: "Nature's Sanctuary", Description: "Beasts and plants must save before attacking you"},
+
+	// Fighter
+	{Class: "fighter", Level: 1, Name: "Fighting Style", Description: "Adopt a particular style of fighting as your specialty"},
+	{Class: "fighter", Level: 1, Name: "Second Wind", Description: "Bonus action: regain 1d10 + fighter level hit points"},
+	{Class: "fighter", Level: 2, Name: "Action Surge", Description: "Take one additional action on your turn"},
+	{Class: "fighter", Level: 5, Name: "Extra Attack", Description: "Attack twice when you take the Attack action"},
+	{Class: "fighter", Level: 9, Name: "Indomitable", Description: "Reroll a failed saving throw"},
+	{Class: "fighter", Level: 11, Name: "Extra Attack (2)", Description: "Attack three times when you take the Attack action"},
+	{Class: "fighter", Level: 20, Name: "Extra Attack (3)", Description: "Attack four times when you take the Attack action"},
+	{Class: "fighter", Subclass: "Champion", Level: 3, Name: "Improved Critical", Description: "Weapon attacks score a critical hit on a roll of 19 or 20"},
+	{Class: "fighter", Subclass: "Champion", Level: 7, Name: "Remarkable Athlete", Description: "Add half your proficiency bonus to Str, Dex and Con checks you aren't proficient in"},
+	{Class: "fighter", Subclass: "Champion", Level: 10, Name: "Additional Fighting Style", Description: "Choose a second Fighting Style"},
+	{Class: "fighter", Subclass: "Champion", Level: 15, Name: "Superior Critical", Description: "Weapon attacks score a critical hit on a roll of 18-20"},
+	{Class: "fighter", Subclass: "Champion", Level: 18, Name: "Survivor", Description: "Regain 5 + Con modifier hit points each turn while below half hit points"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 3, Name: "Spellcasting", Description: "Learn and cast wizard spells using Intelligence"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 3, Name: "Weapon Bond", Description: "Bond with up to two weapons, which can't be disarmed and can be summoned as a bonus action"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 7, Name: "War Magic", Description: "After casting a cantrip, make one weapon attack as a bonus action"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 10, Name: "Eldritch Strike", Description: "A creature hit by your weapon attack has disadvantage on its next save against your spells"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 15, Name: "Arcane Charge", Description: "Teleport up to 30 ft when you use Action Surge"},
+	{Class: "fighter", Subclass: "Eldritch Knight", Level: 18, Name: "Improved War Magic", Description: "After casting a spell, make one weapon attack as a bonus action"},
+
+	// Monk
+	{Class: "monk", Level: 1, Name: "Unarmored Defense", Description: "Without armor or shield, AC equals 10 + Dex modifier + Wis modifier"},
+	{Class: "monk", Level: 1, Name: "Martial Arts", Description: "Use Dex for unarmed strikes and monk weapons, and make a bonus action unarmed strike"},
+	{Class: "monk", Level: 2, Name: "Ki", Description: "Spend ki points on Flurry of Blows, Patient Defense and Step of the Wind"},
+	{Class: "monk", Level: 2, Name: "Unarmored Movement", Description: "Speed increases while not wearing armor or a shield"},
+	{Class: "monk", Level: 3, Name: "Deflect Missiles", Description: "Reaction: reduce damage from a ranged weapon attack"},
+	{Class: "monk", Level: 4, Name: "Slow Fall", Description: "Reaction: reduce falling damage by five times your monk level"},
+	{Class: "monk", Level: 5, Name: "Extra Attack", Description: "Attack twice when you take the Attack action"},
+	{Class: "monk", Level: 5, Name: "Stunning Strike", Description: "Spend 1 ki to try to stun a creature you hit"},
+	{Class: "monk", Level: 6, Name: "Ki-Empowered Strikes", Description: "Unarmed strikes count as magical"},
+	{Class: "monk", Level: 7, Name: "Evasion", Description: "Take no damage on a successful Dex save for half damage, half on a failure"},
+	{Class: "monk", Level: 7, Name: "Stillness of Mind", Description: "Action: end one effect causing you to be charmed or frightened"},
+	{Class: "monk", Level: 10, Name: "Purity of Body", Description: "Immune to disease and poison"},
+	{Class: "monk", Level: 13, Name: "Tongue of the Sun and Moon", Description: "Understand and be understood in any spoken language"},
+	{Class: "monk", Level: 14, Name: "Diamond Soul", Description: "Proficiency in all saving throws"},
+	{Class: "monk", Level: 15, Name: "Timeless Body", Description: "No frailty of old age and no need for food or water"},
+	{Class: "monk", Level: 18, Name: "Empty Body", Description: "Spend ki to become invisible or cast Astral Projection"},
+	{Class: "monk", Level: 20, Name: "Perfect Self", Description: "Regain 4 ki points when rolling initiative with none left"},
+	{Class: "monk", Subclass: "Way of the Open Hand", Level: 3, Name: "Open Hand Technique", Description: "Flurry of Blows hits can knock prone, push or deny reactions"},
+	{Class: "monk", Subclass: "Way of the Open Hand", Level: 6, Name: "Wholeness of Body", Description: "Action: regain hit points equal to three times your monk level"},
+	{Class: "monk", Subclass: "Way of the Open Hand", Level: 11, Name: "Tranquility", Description: "Gain the effect of a Sanctuary spell after a long rest"},
+	{Class: "monk", Subclass: "Way of the Open Hand", Level: 17, Name: "Quivering Palm", Description: "Set up lethal vibrations in a creature you hit"},
+
+	// Paladin
+	{Class: "paladin", Level: 1, Name: "Divine Sense", Description: "Detect celestials, fiends and undead within 60 ft"},
+	{Class: "paladin", Level: 1, Name: "Lay on Hands", Description: "Restore hit points from a pool of five times your paladin level"},
+	{Class: "paladin", Level: 2, Name: "Fighting Style", Description: "Adopt a particular style of fighting as your specialty"},
+	{Class: "paladin", Level: 2, Name: "Spellcasting", Description: "Prepare and cast paladin spells using Charisma"},
+	{Class: "paladin", Level: 2, Name: "Divine Smite", Description: "Expend a spell slot to deal extra radiant damage on a melee hit"},
+	{Class: "paladin", Level: 3, Name: "Divine Health", Description: "Immune to disease"},
+	{Class: "paladin", Level: 5, Name: "Extra Attack", Description: "Attack twice when you take the Attack action"},
+	{Class: "paladin", Level: 6, Name: "Aura of Protection", Description: "You and allies within 10 ft add your Cha modifier to saving throws"},
+	{Class: "paladin", Level: 10, Name: "Aura of Courage", Description: "You and allies within 10 ft can't be frightened"},
+	{Class: "paladin", Level: 11, Name: "Improved Divine Smite", Description: "Melee weapon hits deal an extra 1d8 radiant damage"},
+	{Class: "paladin", Level: 14, Name: "Cleansing Touch", Description: "Action: end one spell on yourself or a willing creature"},
+	{Class: "paladin", Subclass: "Oath of Devotion", Level: 3, Name: "Channel Divinity: Sacred Weapon", Description: "Add your Cha modifier to attack rolls with a weapon for 1 minute"},
+	{Class: "paladin", Subclass: "Oath of Devotion", Level: 3, Name: "Channel Divinity: Turn the Unholy", Description: "Turn fiends and undead"},
+	{Class: "paladin", Subclass: "Oath of Devotion", Level: 7, Name: "Aura of Devotion", Description: "You and allies within 10 ft can't be charmed"},
+	{Class: "paladin", Subclass: "Oath of Devotion", Level: 15, Name: "Purity of Spirit", Description: "Always under the effect of Protection from Evil and Good"},
+	{Class: "paladin", Subclass: "Oath of Devotion", Level: 20, Name: "Holy Nimbus", Description: "Emanate sunlight that damages enemies"},
+
+	// Ranger
+	{Class: "ranger", Level: 1, Name: "Favored Enemy", Description: "Advantage on Survival checks to track and Int checks to recall information about chosen enemies"},
+	{Class: "ranger", Level: 1, Name: "Natural Explorer", Description: "Expertise in travel and survival in a favored terrain"},
+	{Class: "ranger", Level: 2, Name: "Fighting Style", Description: "Adopt a particular style of fighting as your specialty"},
+	{Class: "ranger", Level: 2, Name: "Spellcasting", Description: "Cast ranger spells using Wisdom"},
+	{Class: "ranger", Level: 3, Name: "Primeval Awareness", Description: "Expend a spell slot to sense certain creature types nearby"},
+	{Class: "ranger", Level: 5, Name: "Extra Attack", Description: "Attack twice when you take the Attack action"},
+	{Class: "ranger", Level: 8, Name: "Land's Stride", Description: "Nonmagical difficult terrain costs no extra movement"},
+	{Class: "ranger", Level: 10, Name: "Hide in Plain Sight", Description: "Camouflage yourself for +10 to Stealth checks"},
+	{Class: "ranger", Level: 14, Name: "Vanish", Description: "Hide as a bonus action and can't be tracked by nonmagical means"},
+	{Class: "ranger", Level: 18, Name: "Feral Senses", Description: "No disadvantage attacking creatures you can't see"},
+	{Class: "ranger", Level: 20, Name: "Foe Slayer", Description: "Add your Wis modifier to one attack or damage roll per turn against a favored enemy"},
+	{Class: "ranger", Subclass: "Hunter", Level: 3, Name: "Hunter's Prey", Description: "Choose Colossus Slayer, Giant Killer or Horde Breaker"},
+	{Class: "ranger", Subclass: "Hunter", Level: 7, Name: "Defensive Tactics", Description: "Choose Escape the Horde, Multiattack Defense or Steel Will"},
+	{Class: "ranger", Subclass: "Hunter", Level: 11, Name: "Multiattack", Description: "Choose Volley or Whirlwind Attack"},
+	{Class: "ranger", Subclass: "Hunter", Level: 15, Name: "Superior Hunter's Defense", Description: "Choose Evasion, Stand Against the Tide or Uncanny Dodge"},
+
+	// Rogue
+	{Class: "rogue", Level: 1, Name: "Expertise", Description: "Double proficiency bonus for two chosen skill proficiencies"},
+	{Class: "rogue", Level: 1, Name: "Sneak Attack", Description: "Extra damage once per turn with advantage or an ally next to the target"},
+	{Class: "rogue", Level: 1, Name: "Thieves' Cant", Description: "Secret mix of dialect, jargon and code"},
+	{Class: "rogue", Level: 2, Name: "Cunning Action", Description: "Dash, Disengage or Hide as a bonus action"},
+	{Class: "rogue", Level: 5, Name: "Uncanny Dodge", Description: "Reaction: halve the damage of an attack you can see"},
+	{Class: "rogue", Level: 7, Name: "Evasion", Description: "Take no damage on a successful Dex save for half damage, half on a failure"},
+	{Class: "rogue", Level: 11, Name: "Reliable Talent", Description: "Treat d20 rolls of 9 or lower as 10 on proficient ability checks"},
+	{Class: "rogue", Level: 14, Name: "Blindsense", Description: "Aware of hidden or invisible creatures within 10 ft"},
+	{Class: "rogue", Level: 15, Name: "Slippery Mind", Description: "Proficiency in Wisdom saving throws"},
+	{Class: "rogue", Level: 18, Name: "Elusive", Description: "No attack roll has advantage against you while you aren't incapacitated"},
+	{Class: "rogue", Level: 20, Name: "Stroke of Luck", Description: "Turn a miss into a hit or a failed check into a 20"},
+	{Class: "rogue", Subclass: "Thief", Level: 3, Name: "Fast Hands", Description: "Use Cunning Action for Sleight of Hand, thieves' tools or Use an Object"},
+	{Class: "rogue", Subclass: "Thief", Level: 3, Name: "Second-Story Work", Description: "Climb at full speed and jump farther"},
+	{Class: "rogue", Subclass: "Thief", Level: 9, Name: "Supreme Sneak", Description: "Advantage on Stealth checks when moving at half speed"},
+	{Class: "rogue", Subclass: "Thief", Level: 13, Name: "Use Magic Device", Description: "Ignore class, race and level requirements on magic items"},
+	{Class: "rogue", Subclass: "Thief", Level: 17, Name: "Thief's Reflexes", Description: "Take two turns in the first round of combat"},
+	{Class: "rogue", Subclass: "Arcane Trickster", Level: 3, Name: "Spellcasting", Description: "Learn and cast wizard spells using Intelligence"},
+	{Class: "rogue", Subclass: "Arcane Trickster", Level: 3, Name: "Mage Hand Legerdemain", Description: "Your Mage Hand is invisible and can pick locks and pockets"},
+	{Class: "rogue", Subclass: "Arcane Trickster", Level: 9, Name: "Magical Ambush", Description: "Creatures have disadvantage on saves against spells you cast while hidden"},
+	{Class: "rogue", Subclass: "Arcane Trickster", Level: 13, Name: "Versatile Trickster", Description: "Bonus action: use your Mage Hand to gain advantage on attacks against a creature"},
+	{Class: "rogue", Subclass: "Arcane Trickster", Level: 17, Name: "Spell Thief", Description: "Reaction: steal a spell cast at you by a creature that fails its save"},
+
+	// Sorcerer
+	{Class: "sorcerer", Level: 1, Name: "Spellcasting", Description: "Cast sorcerer spells using Charisma"},
+	{Class: "sorcerer", Level: 2, Name: "Font of Magic", Description: "Sorcery points that convert to and from spell slots"},
+	{Class: "sorcerer", Level: 3, Name: "Metamagic", Description: "Twist spells with sorcery points"},
+	{Class: "sorcerer", Level: 20, Name: "Sorcerous Restoration", Description: "Regain 4 sorcery points on a short rest"},
+	{Class: "sorcerer", Subclass: "Draconic Bloodline", Level: 1, Name: "Dragon Ancestor", Description: "Speak Draconic; double proficiency on Cha checks with dragons"},
+	{Class: "sorcerer", Subclass: "Draconic Bloodline", Level: 1, Name: "Draconic Resilience", Description: "+1 hit point per sorcerer level; without armor, AC equals 13 + Dex modifier"},
+	{Class: "sorcerer", Subclass: "Draconic Bloodline", Level: 6, Name: "Elemental Affinity", Description: "Add your Cha modifier to damage of your ancestry's type"},
+	{Class: "sorcerer", Subclass: "Draconic Bloodline", Level: 14, Name: "Dragon Wings", Description: "Sprout wings and gain a flying speed equal to your speed"},
+	{Class: "sorcerer", Subclass: "Draconic Bloodline", Level: 18, Name: "Draconic Presence", Description: "Spend sorcery points to exude an aura of awe or fear"},
+
+	// Warlock
+	{Class: "warlock", Level: 1, Name: "Pact Magic", Description: "Cast warlock spells using Charisma; slots recharge on a short rest"},
+	{Class: "warlock", Level: 2, Name: "Eldritch Invocations", Description: "Fragments of forbidden knowledge that grant magical abilities"},
+	{Class: "warlock", Level: 3, Name: "Pact Boon", Description: "Choose Pact of the Chain, Blade or Tome"},
+	{Class: "warlock", Level: 11, Name: "Mystic Arcanum", Description: "Cast one 6th-level spell once per long rest"},
+	{Class: "warlock", Level: 20, Name: "Eldritch Master", Description: "Regain all Pact Magic slots with 1 minute of entreaty"},
+	{Class: "warlock", Subclass: "The Fiend", Level: 1, Name: "Dark One's Blessing", Description: "Gain temporary hit points equal to Cha modifier + warlock level when reducing a hostile creature to 0 HP"},
+	{Class: "warlock", Subclass: "The Fiend", Level: 6, Name: "Dark One's Own Luck", Description: "Add a d10 to an ability check or saving throw"},
+	{Class: "warlock", Subclass: "The Fiend", Level: 10, Name: "Fiendish Resilience", Description: "Choose a damage type to gain resistance to after a rest"},
+	{Class: "warlock", Subclass: "The Fiend", Level: 14, Name: "Hurl Through Hell", Description: "Send a creature you hit through the lower planes"},
+
+	// Wizard
+	{Class: "wizard", Level: 1, Name: "Spellcasting", Description: "Prepare and cast wizard spells from your spellbook using Intelligence"},
+	{Class: "wizard", Level: 1, Name: "Arcane Recovery", Description: "Recover spell slots during a short rest once per day"},
+	{Class: "wizard", Level: 18, Name: "Spell Mastery", Description: "Cast a chosen 1st- and 2nd-level spell at will"},
+	{Class: "wizard", Level: 20, Name: "Signature Spells", Description: "Two 3rd-level spells always prepared, each castable once per short rest without a slot"},
+	{Class: "wizard", Subclass: "School of Evocation", Level: 2, Name: "Evocation Savant", Description: "Copying evocation spells costs half the gold and time"},
+	{Class: "wizard", Subclass: "School of Evocation", Level: 2, Name: "Sculpt Spells", Description: "Protect allies from your evocation spells"},
+	{Class: "wizard", Subclass: "School of Evocation", Level: 6, Name: "Potent Cantrip", Description: "Damaging cantrips deal half damage on a successful save"},
+	{Class: "wizard", Subclass: "School of Evocation", Level: 10, Name: "Empowered Evocation", Description: "Add your Int modifier to one damage roll of an evocation spell"},
+	{Class: "wizard", Subclass: "School of Evocation", Level: 14, Name: "Overchannel", Description: "Deal maximum damage with a spell of 5th level or lower"},
+}
+
+// Subclasses returns the subclasses in the feature catalog for a class
+func Subclasses(class string) []string {
+	seen := make(map[string]bool)
+	var subclasses []string
+	for _, f := range featureCatalog {
+		if f.Subclass != "" && strings.EqualFold(f.Class, class) && !seen[f.Subclass] {
+			seen[f.Subclass] = true
+			subclasses = append(subclasses, f.Subclass)
+		}
+	}
+	return subclasses
+}
+
+// findSubclass matches a subclass name for a class, accepting a partial name such as "evocation"
+func findSubclass(class, name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", false
+	}
+	for _, subclass := range Subclasses(class) {
+		if strings.Contains(strings.ToLower(subclass), name) {
+			return subclass, true
+		}
+	}
+	return "", false
+}
+
+// classFeatures returns the catalog features a class and subclass gain between two class levels (exclusive, inclusive)
+func classFeatures(class, subclass string, fromLevel, toLevel int) []ClassFeature {
+	var features []ClassFeature
+	for _, f := range featureCatalog {
+		if !strings.EqualFold(f.Class, class) || f.Level <= fromLevel || f.Level > toLevel {
+			continue
+		}
+		if f.Subclass == "" || strings.EqualFold(f.Subclass, subclass) {
+			features = append(features, f)
+		}
+	}
+	sort.SliceStable(features, func(i, j int) bool { return features[i].Level < features[j].Level })
+	return features
+}
+
+// Features returns every class and subclass feature the character has, per class in level order
+func (c *Character) Features() []ClassFeature {
+	var features []ClassFeature
+	for _, cl := range c.ClassLevels() {
+		features = append(features, classFeatures(cl.Class, cl.Subclass, 0, cl.Level)...)
+	}
+	return features
+}
+
+// HasFeature checks if the character has a class or subclass feature
+func (c *Character) HasFeature(name string) bool {
+	for _, f := range c.Features() {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Subclass returns the subclass chosen for one of the character's classes, empty if none
+func (c *Character) Subclass(class string) string {
+	for _, cl := range c.ClassLevels() {
+		if strings.EqualFold(cl.Class, class) {
+			return cl.Subclass
+		}
+	}
+	return ""
+}
+
+// ClassesNeedingSubclass returns the classes that are high enough level to choose a subclass but haven't yet
+func (c *Character) ClassesNeedingSubclass() []string {
+	var classes []string
+	for _, cl := range c.ClassLevels() {
+		if cl.Subclass == "" && cl.Level >= SubclassLevel(cl.Class) && len(Subclasses(cl.Class)) > 0 {
+			classes = append(classes, cl.Class)
+		}
+	}
+	return classes
+}
+
+// ChooseSubclass picks the subclass for one of the character's classes and returns the subclass features gained
+// D&D 5e rule: the subclass is chosen at a fixed class level and can't be changed afterwards
+func (c *Character) ChooseSubclass(class, subclass string) ([]ClassFeature, error) {
+	classes := append([]ClassLevel(nil), c.ClassLevels()...)
+
+	for i, cl := range classes {
+		if !strings.EqualFold(cl.Class, class) {
+			continue
+		}
+		if cl.Subclass != "" {
+			return nil, ErrSubclassAlreadyChosen
+		}
+		if cl.Level < SubclassLevel(cl.Class) {
+			return nil, ErrSubclassLevelTooLow
+		}
+		name, ok := findSubclass(cl.Class, subclass)
+		if !ok {
+			return nil, ErrUnknownSubclass
+		}
+
+		oldMaxHP := c.MaxHitPoints()
+		oldSlots := c.SpellSlots
+		classes[i].Subclass = name
+		c.Classes = classes
+		c.applyMaxHitPointChange(oldMaxHP)
+		c.RefreshResources()
+
+		// Subclasses such as the Eldritch Knight grant spellcasting, with the new spell slots ready to use
+		c.SpellSlots = c.GetSpellSlots()
+		for slotLevel, n := range c.SpellSlots {
+			if gained := n - oldSlots[slotLevel]; gained > 0 {
+				if c.CurrentSpellSlots == nil {
+					c.CurrentSpellSlots = make(map[int]int)
+				}
+				c.CurrentSpellSlots[slotLevel] += gained
+			}
+		}
+
+		var gained []ClassFeature
+		for _, f := range classFeatures(cl.Class, name, 0, cl.Level) {
+			if f.Subclass != "" {
+				gained = append(gained, f)
+			}
+		}
+		return gained, nil
+	}
+
+	return nil, ErrClassNotTaken
+}
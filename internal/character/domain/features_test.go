@@ -0,0 +1,120 @@
+package domain
+
+import "testing"
+
+func TestCharacter_LevelUpGrantsFeatures(t *testing.T) {
+	char := NewCharacter("Test", "human", "fighter", 1, 15, 12, 14, 10, 10, 10, "soldier", nil)
+
+	result, err := char.SetClassLevel("fighter", 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Features) != 1 || result.Features[0].Name != "Action Surge" {
+		t.Errorf("Expected Action Surge for levels 2-3, got %v", result.Features)
+	}
+	if !result.NeedsSubclass {
+		t.Error("Expected a level 3 fighter to need a subclass")
+	}
+
+	gained, err := char.ChooseSubclass("fighter", "champion")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(gained) != 1 || gained[0].Name != "Improved Critical" {
+		t.Errorf("Expected Improved Critical from Champion, got %v", gained)
+	}
+	if _, err := char.ChooseSubclass("fighter", "champion"); err != ErrSubclassAlreadyChosen {
+		t.Errorf("Expected ErrSubclassAlreadyChosen, got %v", err)
+	}
+
+	result, err = char.SetClassLevel("fighter", 7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !char.HasFeature("Remarkable Athlete") || result.NeedsSubclass {
+		t.Errorf("Expected Remarkable Athlete at level 7 without another subclass prompt, got %v", result.Features)
+	}
+}
+
+func TestCharacter_FightingStyleDefense(t *testing.T) {
+	chainMail := &ArmorStats{Name: "Chain Mail", Category: "Heavy", BaseAC: 16, StrMinimum: 13}
+	char := &Character{Class: "fighter", Level: 1, Dex: 10, Armor: "Chain Mail", ArmorStats: chainMail}
+
+	if err := char.ChooseFightingStyle("defense"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ac := char.ArmorClass(); ac != 17 {
+		t.Errorf("Expected AC 17 with chain mail and Defense, got %d", ac)
+	}
+
+	char.Armor, char.ArmorStats = "", nil
+	if ac := char.ArmorClass(); ac != 10 {
+		t.Errorf("Expected Defense not to apply without armor, got AC %d", ac)
+	}
+
+	wizard := &Character{Class: "wizard", Level: 5}
+	if err := wizard.ChooseFightingStyle("defense"); err != ErrNoFightingStyleFeature {
+		t.Errorf("Expected ErrNoFightingStyleFeature for a wizard, got %v", err)
+	}
+}
+
+func TestCharacter_FastMovement(t *testing.T) {
+	char := &Character{Race: "human", Class: "barbarian", Level: 5, Str: 16}
+	if speed := char.Speed(); speed != 40 {
+		t.Errorf("Expected Fast Movement to give a level 5 barbarian 40 ft, got %d", speed)
+	}
+
+	char.ArmorStats = &ArmorStats{Name: "Plate", Category: "Heavy", BaseAC: 18, StrMinimum: 15}
+	if speed := char.Speed(); speed != 30 {
+		t.Errorf("Expected no Fast Movement in heavy armor, got %d", speed)
+	}
+
+	char.Level = 4
+	char.ArmorStats = nil
+	if speed := char.Speed(); speed != 30 {
+		t.Errorf("Expected no Fast Movement before level 5, got %d", speed)
+	}
+}
+
+func TestCharacter_UnarmoredMovement(t *testing.T) {
+	tests := []struct {
+		level    int
+		expected int
+	}{
+		{1, 30},
+		{2, 40},
+		{6, 45},
+		{10, 50},
+		{14, 55},
+		{18, 60},
+	}
+
+	for _, tt := range tests {
+		char := &Character{Race: "human", Class: "monk", Level: tt.level}
+		if speed := char.Speed(); speed != tt.expected {
+			t.Errorf("Expected a level %d monk to move %d ft, got %d", tt.level, tt.expected, speed)
+		}
+	}
+
+	shield := &Character{Race: "human", Class: "monk", Level: 6, ShieldStats: &ArmorStats{Name: "Shield", Category: "Shield", BaseAC: 2}}
+	if speed := shield.Speed(); speed != 30 {
+		t.Errorf("Expected no Unarmored Movement with a shield, got %d", speed)
+	}
+}
+
+func TestCharacter_FeralInstinct(t *testing.T) {
+	char := &Character{Race: "human", Class: "barbarian", Level: 6}
+	if char.InitiativeMode().Advantage {
+		t.Error("Expected no initiative advantage before Feral Instinct")
+	}
+
+	char.Level = 7
+	if mode := char.InitiativeMode(); mode.String() != "advantage" {
+		t.Errorf("Expected Feral Instinct to give advantage on initiative, got %+v", mode)
+	}
+
+	char.Exhaustion = 1
+	if mode := char.InitiativeMode(); mode.String() != "" {
+		t.Errorf("Expected exhaustion disadvantage to cancel Feral Instinct, got %+v", mode)
+	}
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// FightingStyle describes a Fighting Style and the bonuses the character sheet applies for it
+type FightingStyle struct {
+	Name        string
+	Description string
+
+	ArmorClassBonus   int  // while wearing armor
+	RangedAttackBonus int  // attack rolls with ranged weapons
+	OneHandedDamage   int  // damage with a melee weapon held in one hand
+	OffHandDamage     bool // add the ability modifier to off-hand attack damage
+}
+
+// fightingStyles holds the D&D 5e Fighting Style options, keyed by lowercase name
+var fightingStyles = map[string]FightingStyle{
+	"archery": {
+		Name:              "Archery",
+		Description:       "+2 bonus to attack rolls with ranged weapons",
+		RangedAttackBonus: 2,
+	},
+	"defense": {
+		Name:            "Defense",
+		Description:     "+1 bonus to AC while wearing armor",
+		ArmorClassBonus: 1,
+	},
+	"dueling": {
+		Name:            "Dueling",
+		Description:     "+2 bonus to damage rolls with a melee weapon in one hand and no other weapons",
+		OneHandedDamage: 2,
+	},
+	"great weapon fighting": {
+		Name:        "Great Weapon Fighting",
+		Description: "Reroll 1s and 2s on damage dice for two-handed or versatile melee weapons",
+	},
+	"protection": {
+		Name:        "Protection",
+		Description: "Reaction: impose disadvantage on an attack against an adjacent ally while wielding a shield",
+	},
+	"two-weapon fighting": {
+		Name:          "Two-Weapon Fighting",
+		Description:   "Add your ability modifier to the damage of the second attack when fighting with two weapons",
+		OffHandDamage: true,
+	},
+}
+
+// LookupFightingStyle finds a Fighting Style by name (case-insensitive)
+func LookupFightingStyle(name string) (FightingStyle, bool) {
+	style, ok := fightingStyles[strings.ToLower(strings.TrimSpace(name))]
+	return style, ok
+}
+
+// FightingStyleNames returns the names of all Fighting Styles in alphabetical order
+func FightingStyleNames() []string {
+	names := make([]string, 0, len(fightingStyles))
+	for _, style := range fightingStyles {
+		names = append(names, style.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ChooseFightingStyle sets the character's Fighting Style
+// D&D 5e rule: only classes with the Fighting Style feature (fighter, paladin, ranger) can choose one
+func (c *Character) ChooseFightingStyle(name string) error {
+	if !c.HasFeature("Fighting Style") {
+		return ErrNoFightingStyleFeature
+	}
+	style, ok := LookupFightingStyle(name)
+	if !ok {
+		return ErrUnknownFightingStyle
+	}
+	c.FightingStyle = style.Name
+	return nil
+}
+
+// fightingStyle returns the character's chosen Fighting Style, or an empty style if none
+func (c *Character) fightingStyle() FightingStyle {
+	style, _ := LookupFightingStyle(c.FightingStyle)
+	return style
+}
+
+// FightingStyleAttackBonus returns the attack roll bonus the Fighting Style gives a weapon attack
+func (c *Character) FightingStyleAttackBonus(ranged bool) int {
+	if ranged {
+		return c.fightingStyle().RangedAttackBonus
+	}
+	return 0
+}
+
+// FightingStyleDamageBonus returns the damage bonus the Fighting Style gives a weapon attack
+// otherHandBusy is true for two-handed weapons and while wielding a second weapon
+func (c *Character) FightingStyleDamageBonus(melee, otherHandBusy bool) int {
+	if melee && !otherHandBusy {
+		return c.fightingStyle().OneHandedDamage
+	}
+	return 0
+}
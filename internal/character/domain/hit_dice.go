@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HitDice represents the hit dice of a single size a character can spend to recover hit points
+// D&D 5e rule: a character has one hit die per level, sized by class
+type HitDice struct {
+	Die       int `json:"die"`       // number of sides, e.g. 10 for a d10
+	Total     int `json:"total"`     // one per level in classes using this die
+	Remaining int `json:"remaining"` // dice not yet spent since the last long rest
+}
+
+// NewHitDice creates a full hit dice pool
+func NewHitDice(die, total int) HitDice {
+	return HitDice{Die: die, Total: total, Remaining: total}
+}
+
+// String formats the pool total in dice notation, e.g. "5d10"
+func (h HitDice) String() string {
+	return fmt.Sprintf("%dd%d", h.Total, h.Die)
+}
+
+// Spend uses one hit die from the pool
+func (h *HitDice) Spend() error {
+	if h.Remaining <= 0 {
+		return ErrNoHitDice
+	}
+	h.Remaining--
+	return nil
+}
+
+// Recover regains up to n spent hit dice and returns how many were actually recovered
+func (h *HitDice) Recover(n int) int {
+	recovered := min(n, h.Total-h.Remaining)
+	if recovered < 0 {
+		recovered = 0
+	}
+	h.Remaining += recovered
+	return recovered
+}
+
+// Resize changes the pool after a level change; dice gained by levelling up are immediately available
+func (h *HitDice) Resize(die, total int) {
+	h.Remaining += total - h.Total
+	h.Remaining = max(0, min(h.Remaining, total))
+	h.Die = die
+	h.Total = total
+}
+
+// HitDicePool holds one HitDice entry per die size, largest die first
+// D&D 5e rule: a multiclass character's hit dice keep the size of the class they came from
+type HitDicePool []HitDice
+
+// NewHitDicePool creates a full pool with one hit die per class level
+func NewHitDicePool(classes []ClassLevel) HitDicePool {
+	var pool HitDicePool
+	pool.Resize(classes)
+	return pool
+}
+
+// Total returns the number of hit dice in the pool
+func (p HitDicePool) Total() int {
+	total := 0
+	for _, h := range p {
+		total += h.Total
+	}
+	return total
+}
+
+// Remaining returns the number of hit dice that haven't been spent
+func (p HitDicePool) Remaining() int {
+	remaining := 0
+	for _, h := range p {
+		remaining += h.Remaining
+	}
+	return remaining
+}
+
+// String formats the pool in dice notation, e.g. "5d10" or "3d10 + 2d6"
+func (p HitDicePool) String() string {
+	parts := make([]string, 0, len(p))
+	for _, h := range p {
+		parts = append(parts, h.String())
+	}
+	return strings.Join(parts, " + ")
+}
+
+// Spend uses the largest remaining hit die and returns its size
+func (p HitDicePool) Spend() (int, error) {
+	for i := range p {
+		if p[i].Spend() == nil {
+			return p[i].Die, nil
+		}
+	}
+	return 0, ErrNoHitDice
+}
+
+// Recover regains up to n spent hit dice, largest first, and returns how many were actually recovered
+func (p HitDicePool) Recover(n int) int {
+	recovered := 0
+	for i := range p {
+		recovered += p[i].Recover(n - recovered)
+	}
+	return recovered
+}
+
+// Resize updates the pool after a level change; dice gained by levelling up are immediately available
+func (p *HitDicePool) Resize(classes []ClassLevel) {
+	totals := make(map[int]int)
+	for _, cl := range classes {
+		totals[hitDieForClass(cl.Class)] += cl.Level
+	}
+
+	resized := HitDicePool{}
+	for die, total := range totals {
+		h := HitDice{Die: die}
+		for _, existing := range *p {
+			if existing.Die == die {
+				h = existing
+			}
+		}
+		h.Resize(die, total)
+		resized = append(resized, h)
+	}
+	sort.Slice(resized, func(i, j int) bool { return resized[i].Die > resized[j].Die })
+	*p = resized
+}
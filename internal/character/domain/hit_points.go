@@ -0,0 +1,128 @@
+package domain
+
+// DamageResult describes how a single instance of damage was applied
+type DamageResult struct {
+	Absorbed     int  // damage soaked up by temporary hit points
+	Taken        int  // hit points actually lost
+	InstantDeath bool // massive damage killed the character outright
+	FailedSave   bool // damage at 0 HP counted as a failed death save
+
+	ConcentrationDC   int    // DC of the Constitution save to keep concentrating, 0 if not concentrating
+	LostConcentration string // spell the character stopped concentrating on by dropping to 0 HP
+}
+
+// TakeDamage applies damage to the character following D&D 5e rules:
+// temporary hit points are lost first, and if the damage left over after
+// dropping to 0 HP equals or exceeds the hit point maximum the character dies instantly.
+// Damage taken while already at 0 HP counts as a failed death save
+func (c *Character) TakeDamage(amount int) (DamageResult, error) {
+	var result DamageResult
+
+	if amount < 0 {
+		return result, ErrInvalidHitPointAmount
+	}
+	if c.Dead {
+		return result, ErrCharacterDead
+	}
+
+	// Temporary hit points absorb damage first
+	result.Absorbed = min(c.TempHP, amount)
+	c.TempHP -= result.Absorbed
+	remaining := amount - result.Absorbed
+	if remaining == 0 {
+		result.ConcentrationDC = c.concentrationDC(amount)
+		return result, nil
+	}
+
+	// Damage beyond current HP only matters for the massive damage rule
+	wasDown := c.CurrentHP == 0
+	result.Taken = min(c.CurrentHP, remaining)
+	overflow := remaining - result.Taken
+	c.CurrentHP -= result.Taken
+
+	switch {
+	case c.CurrentHP == 0 && overflow >= c.MaxHitPoints():
+		c.Dead = true
+		result.InstantDeath = true
+	case wasDown:
+		// D&D 5e rule: taking damage at 0 hit points counts as a failed death save
+		c.addDeathSaveFailures(1)
+		result.FailedSave = true
+	case c.CurrentHP == 0:
+		// Dropping to 0 hit points starts a fresh set of death saves
+		c.DeathSaves = DeathSaves{}
+	}
+
+	// An unconscious character can't keep concentrating; otherwise the damage calls for a concentration check
+	if c.CurrentHP == 0 {
+		result.LostConcentration = c.Concentration
+		c.Concentration = ""
+	} else {
+		result.ConcentrationDC = c.concentrationDC(amount)
+	}
+
+	return result, nil
+}
+
+// concentrationDC returns the DC of the concentration check for damage, or 0 if the character isn't concentrating
+func (c *Character) concentrationDC(damage int) int {
+	if c.Concentration == "" || damage == 0 {
+		return 0
+	}
+	return ConcentrationDC(damage)
+}
+
+// Heal restores hit points up to the hit point maximum and returns the amount actually healed
+// D&D 5e rule: healing can't raise current hit points above the maximum
+func (c *Character) Heal(amount int) (int, error) {
+	if amount < 0 {
+		return 0, ErrInvalidHitPointAmount
+	}
+	if c.Dead {
+		return 0, ErrCharacterDead
+	}
+
+	healed := min(amount, c.MaxHitPoints()-c.CurrentHP)
+	if healed < 0 {
+		healed = 0
+	}
+	c.CurrentHP += healed
+
+	// Regaining any hit points ends the dying state
+	if c.CurrentHP > 0 {
+		c.DeathSaves = DeathSaves{}
+	}
+	return healed, nil
+}
+
+// GrantTempHP gives the character temporary hit points
+// D&D 5e rule: temporary hit points don't stack, the character keeps the higher value
+// Returns true if the new temporary hit points replaced the old ones
+func (c *Character) GrantTempHP(amount int) (bool, error) {
+	if amount < 0 {
+		return false, ErrInvalidHitPointAmount
+	}
+	if amount <= c.TempHP {
+		return false, nil
+	}
+	c.TempHP = amount
+	return true, nil
+}
+
+// applyMaxHitPointChange carries a change of the hit point maximum over to current hit points,
+// e.g. after levelling up or increasing Constitution
+func (c *Character) applyMaxHitPointChange(oldMaxHP int) {
+	if c.Dead {
+		return
+	}
+	maxHP := c.MaxHitPoints()
+	c.CurrentHP = max(0, min(c.CurrentHP+maxHP-oldMaxHP, maxHP))
+}
+
+// AdjustMaxHitPoints changes the hit point maximum by delta, keeping current HP within the new maximum
+func (c *Character) AdjustMaxHitPoints(delta int) {
+	c.MaxHPAdjustment += delta
+	if maxHP := c.MaxHitPoints(); c.CurrentHP > maxHP {
+		c.CurrentHP = maxHP
+	}
+}
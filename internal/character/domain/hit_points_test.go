@@ -0,0 +1,126 @@
+package domain
+
+import "testing"
+
+func TestCharacter_TakeDamage(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentHP     int
+		tempHP        int
+		damage        int
+		expectedHP    int
+		expectedTemp  int
+		expectedDeath bool
+	}{
+		{name: "Regular damage", currentHP: 20, damage: 5, expectedHP: 15},
+		{name: "Temp HP absorbs all", currentHP: 20, tempHP: 8, damage: 5, expectedHP: 20, expectedTemp: 3},
+		{name: "Temp HP absorbs first", currentHP: 20, tempHP: 3, damage: 5, expectedHP: 18},
+		{name: "Drop to zero", currentHP: 5, damage: 12, expectedHP: 0},
+		{name: "Massive damage", currentHP: 5, damage: 40, expectedHP: 0, expectedDeath: true},
+		{name: "Massive damage at zero HP", currentHP: 0, damage: 35, expectedHP: 0, expectedDeath: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Level 3 fighter with Con 10: 10 + 6 + 6 = 22 max HP
+			char := &Character{Class: "fighter", Level: 3, Con: 10, CurrentHP: tt.currentHP, TempHP: tt.tempHP}
+
+			result, err := char.TakeDamage(tt.damage)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if char.CurrentHP != tt.expectedHP {
+				t.Errorf("Expected HP %d, got %d", tt.expectedHP, char.CurrentHP)
+			}
+			if char.TempHP != tt.expectedTemp {
+				t.Errorf("Expected temp HP %d, got %d", tt.expectedTemp, char.TempHP)
+			}
+			if result.InstantDeath != tt.expectedDeath || char.Dead != tt.expectedDeath {
+				t.Errorf("Expected instant death %v, got %v", tt.expectedDeath, result.InstantDeath)
+			}
+		})
+	}
+}
+
+func TestCharacter_Heal(t *testing.T) {
+	char := &Character{Class: "fighter", Level: 3, Con: 10, CurrentHP: 18}
+
+	healed, err := char.Heal(10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if healed != 4 || char.CurrentHP != 22 {
+		t.Errorf("Expected healing capped at max (healed 4, HP 22), got healed %d, HP %d", healed, char.CurrentHP)
+	}
+
+	char.Dead = true
+	if _, err := char.Heal(5); err != ErrCharacterDead {
+		t.Errorf("Expected ErrCharacterDead, got %v", err)
+	}
+}
+
+func TestCharacter_GrantTempHP(t *testing.T) {
+	char := &Character{TempHP: 5}
+
+	if replaced, _ := char.GrantTempHP(3); replaced || char.TempHP != 5 {
+		t.Errorf("Lower temp HP should not replace existing, got %d", char.TempHP)
+	}
+	if replaced, _ := char.GrantTempHP(8); !replaced || char.TempHP != 8 {
+		t.Errorf("Higher temp HP should replace existing, got %d", char.TempHP)
+	}
+}
+
+func TestCharacter_DeathSaves(t *testing.T) {
+	tests := []struct {
+		name     string
+		rolls    []int
+		expected LifeState
+		hp       int
+	}{
+		{name: "Three successes stabilise", rolls: []int{12, 10, 15}, expected: LifeStateStable},
+		{name: "Three failures kill", rolls: []int{5, 12, 2, 9}, expected: LifeStateDead},
+		{name: "Natural 1 counts twice", rolls: []int{1, 4}, expected: LifeStateDead},
+		{name: "Natural 20 regains 1 HP", rolls: []int{3, 20}, expected: LifeStateConscious, hp: 1},
+		{name: "Still dying", rolls: []int{11, 3}, expected: LifeStateDying},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Class: "fighter", Level: 3, Con: 10}
+
+			for _, roll := range tt.rolls {
+				if _, err := char.RollDeathSave(roll); err != nil {
+					t.Fatalf("Unexpected error for roll %d: %v", roll, err)
+				}
+			}
+			if char.LifeState() != tt.expected {
+				t.Errorf("Expected state %s, got %s", tt.expected, char.LifeState())
+			}
+			if char.CurrentHP != tt.hp {
+				t.Errorf("Expected HP %d, got %d", tt.hp, char.CurrentHP)
+			}
+		})
+	}
+}
+
+func TestCharacter_DamageWhileDying(t *testing.T) {
+	char := &Character{Class: "fighter", Level: 3, Con: 10, DeathSaves: DeathSaves{Stable: true}}
+
+	result, err := char.TakeDamage(4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.FailedSave || char.DeathSaves.Failures != 1 || char.LifeState() != LifeStateDying {
+		t.Errorf("Damage while stable should add a failure and resume dying, got %+v (%s)", char.DeathSaves, char.LifeState())
+	}
+
+	if _, err := char.Heal(3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.LifeState() != LifeStateConscious || char.DeathSaves.Failures != 0 {
+		t.Errorf("Healing should reset death saves, got %+v", char.DeathSaves)
+	}
+	if _, err := char.RollDeathSave(15); err != ErrNotDying {
+		t.Errorf("Expected ErrNotDying for a conscious character, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InventoryItem is an item the character carries, referring to an entry in the equipment catalog by name
+type InventoryItem struct {
+	Name     string  `json:"name"`
+	Category string  `json:"category,omitempty"` // equipment catalog category, e.g. "Weapon" or "Armor"
+	Quantity int     `json:"quantity"`
+	Weight   float64 `json:"weight,omitempty"` // in pounds, per item, taken from the equipment catalog
+	Notes    string  `json:"notes,omitempty"`
+	Equipped bool    `json:"equipped,omitempty"`
+}
+
+// String formats the item for display, e.g. "Crossbow bolt x20 (equipped)"
+func (i InventoryItem) String() string {
+	s := i.Name
+	if i.Quantity != 1 {
+		s += fmt.Sprintf(" x%d", i.Quantity)
+	}
+	if i.Equipped {
+		s += " (equipped)"
+	}
+	if i.Notes != "" {
+		s += " - " + i.Notes
+	}
+	return s
+}
+
+// findItem returns the index of an inventory item by name (case-insensitive), or -1
+func (c *Character) findItem(name string) int {
+	name = strings.TrimSpace(name)
+	for i, item := range c.Inventory {
+		if strings.EqualFold(item.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasItem checks if the character carries an item
+func (c *Character) HasItem(name string) bool {
+	return c.findItem(name) >= 0
+}
+
+// AddItem puts items into the inventory, stacking them with items of the same name
+func (c *Character) AddItem(item InventoryItem) (InventoryItem, error) {
+	if item.Quantity < 1 {
+		return InventoryItem{}, ErrInvalidItemQuantity
+	}
+
+	if i := c.findItem(item.Name); i >= 0 {
+		c.Inventory[i].Quantity += item.Quantity
+		if item.Notes != "" {
+			c.Inventory[i].Notes = item.Notes
+		}
+		return c.Inventory[i], nil
+	}
+
+	item.Equipped = false
+	c.Inventory = append(c.Inventory, item)
+	c.syncEquippedItems()
+	return c.Inventory[len(c.Inventory)-1], nil
+}
+
+// RemoveItem takes items out of the inventory; an item that runs out is also unequipped
+func (c *Character) RemoveItem(name string, quantity int) (InventoryItem, error) {
+	if quantity < 1 {
+		return InventoryItem{}, ErrInvalidItemQuantity
+	}
+	i := c.findItem(name)
+	if i < 0 {
+		return InventoryItem{}, ErrItemNotCarried
+	}
+	if c.Inventory[i].Quantity < quantity {
+		return c.Inventory[i], ErrInvalidItemQuantity
+	}
+
+	c.Inventory[i].Quantity -= quantity
+	item := c.Inventory[i]
+	if item.Quantity > 0 {
+		return item, nil
+	}
+
+	c.Inventory = append(c.Inventory[:i], c.Inventory[i+1:]...)
+	if c.IsAttuned(item.Name) {
+		c.Unattune(item.Name)
+	}
+	for _, slot := range []string{SlotMainHand, SlotOffHand, SlotArmor, SlotShield} {
+		if isEquipped(c.equippedIn(slot), item.Name) {
+			c.Unequip(slot)
+		}
+	}
+	item.Equipped = false
+	return item, nil
+}
+
+// isEquipped checks if an equipped item name refers to an inventory item, ignoring a magic bonus prefix
+func isEquipped(equipped, name string) bool {
+	base, _ := ParseMagicBonus(equipped)
+	return name != "" && (strings.EqualFold(equipped, name) || strings.EqualFold(base, name))
+}
+
+// syncEquippedItems marks the inventory items that are currently wielded or worn as equipped
+func (c *Character) syncEquippedItems() {
+	for i := range c.Inventory {
+		name := c.Inventory[i].Name
+		c.Inventory[i].Equipped = isEquipped(c.Weapon, name) || isEquipped(c.OffHand, name) ||
+			isEquipped(c.Armor, name) || isEquipped(c.Shield, name)
+	}
+}
+
+// GetStartingEquipment returns the class's starting equipment (D&D 5e rules, taking the first option of each choice)
+func (cl *Class) GetStartingEquipment() []InventoryItem {
+	classEquipment := map[string][]InventoryItem{
+		"barbarian": {{Name: "Greataxe", Category: "Weapon", Quantity: 1}, {Name: "Handaxe", Category: "Weapon", Quantity: 2}, {Name: "Explorer's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Javelin", Category: "Weapon", Quantity: 4}},
+		"bard":      {{Name: "Rapier", Category: "Weapon", Quantity: 1}, {Name: "Diplomat's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Lute", Category: "Tools", Quantity: 1}, {Name: "Leather Armor", Category: "Armor", Quantity: 1}, {Name: "Dagger", Category: "Weapon", Quantity: 1}},
+		"cleric":    {{Name: "Mace", Category: "Weapon", Quantity: 1}, {Name: "Scale Mail", Category: "Armor", Quantity: 1}, {Name: "Crossbow, light", Category: "Weapon", Quantity: 1}, {Name: "Crossbow bolt", Category: "Adventuring Gear", Quantity: 20}, {Name: "Priest's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Shield", Category: "Armor", Quantity: 1}, {Name: "Emblem", Category: "Adventuring Gear", Quantity: 1}},
+		"druid":     {{Name: "Shield", Category: "Armor", Quantity: 1}, {Name: "Scimitar", Category: "Weapon", Quantity: 1}, {Name: "Leather Armor", Category: "Armor", Quantity: 1}, {Name: "Explorer's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Sprig of mistletoe", Category: "Adventuring Gear", Quantity: 1}},
+		"fighter":   {{Name: "Chain Mail", Category: "Armor", Quantity: 1}, {Name: "Longsword", Category: "Weapon", Quantity: 1}, {Name: "Shield", Category: "Armor", Quantity: 1}, {Name: "Crossbow, light", Category: "Weapon", Quantity: 1}, {Name: "Crossbow bolt", Category: "Adventuring Gear", Quantity: 20}, {Name: "Dungeoneer's Pack", Category: "Adventuring Gear", Quantity: 1}},
+		"monk":      {{Name: "Shortsword", Category: "Weapon", Quantity: 1}, {Name: "Dungeoneer's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Dart", Category: "Weapon", Quantity: 10}},
+		"paladin":   {{Name: "Longsword", Category: "Weapon", Quantity: 1}, {Name: "Shield", Category: "Armor", Quantity: 1}, {Name: "Javelin", Category: "Weapon", Quantity: 5}, {Name: "Priest's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Chain Mail", Category: "Armor", Quantity: 1}, {Name: "Emblem", Category: "Adventuring Gear", Quantity: 1}},
+		"ranger":    {{Name: "Scale Mail", Category: "Armor", Quantity: 1}, {Name: "Shortsword", Category: "Weapon", Quantity: 2}, {Name: "Dungeoneer's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Longbow", Category: "Weapon", Quantity: 1}, {Name: "Quiver", Category: "Adventuring Gear", Quantity: 1}, {Name: "Arrow", Category: "Adventuring Gear", Quantity: 20}},
+		"rogue":     {{Name: "Rapier", Category: "Weapon", Quantity: 1}, {Name: "Shortbow", Category: "Weapon", Quantity: 1}, {Name: "Quiver", Category: "Adventuring Gear", Quantity: 1}, {Name: "Arrow", Category: "Adventuring Gear", Quantity: 20}, {Name: "Burglar's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Leather Armor", Category: "Armor", Quantity: 1}, {Name: "Dagger", Category: "Weapon", Quantity: 2}, {Name: "Thieves' Tools", Category: "Tools", Quantity: 1}},
+		"sorcerer":  {{Name: "Crossbow, light", Category: "Weapon", Quantity: 1}, {Name: "Crossbow bolt", Category: "Adventuring Gear", Quantity: 20}, {Name: "Component pouch", Category: "Adventuring Gear", Quantity: 1}, {Name: "Dungeoneer's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Dagger", Category: "Weapon", Quantity: 2}},
+		"warlock":   {{Name: "Crossbow, light", Category: "Weapon", Quantity: 1}, {Name: "Crossbow bolt", Category: "Adventuring Gear", Quantity: 20}, {Name: "Component pouch", Category: "Adventuring Gear", Quantity: 1}, {Name: "Scholar's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Leather Armor", Category: "Armor", Quantity: 1}, {Name: "Quarterstaff", Category: "Weapon", Quantity: 1}, {Name: "Dagger", Category: "Weapon", Quantity: 2}},
+		"wizard":    {{Name: "Quarterstaff", Category: "Weapon", Quantity: 1}, {Name: "Component pouch", Category: "Adventuring Gear", Quantity: 1}, {Name: "Scholar's Pack", Category: "Adventuring Gear", Quantity: 1}, {Name: "Spellbook", Category: "Adventuring Gear", Quantity: 1}},
+	}
+
+	return classEquipment[strings.ToLower(cl.Name)]
+}
+
+// GetStartingEquipment returns the background's starting equipment and the coins in its pouch (D&D 5e rules)
+func (b *Background) GetStartingEquipment() ([]InventoryItem, Purse) {
+	backgroundEquipment := map[string]struct {
+		Items []InventoryItem
+		Gold  int
+	}{
+		"acolyte":       {[]InventoryItem{{Name: "Emblem", Category: "Adventuring Gear", Quantity: 1}, {Name: "Book", Category: "Adventuring Gear", Quantity: 1, Notes: "prayer book"}, {Name: "Block of incense", Category: "Adventuring Gear", Quantity: 5}, {Name: "Vestments", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 15},
+		"charlatan":     {[]InventoryItem{{Name: "Clothes, fine", Category: "Adventuring Gear", Quantity: 1}, {Name: "Disguise Kit", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 15},
+		"criminal":      {[]InventoryItem{{Name: "Crowbar", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1, Notes: "dark, with a hood"}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 15},
+		"entertainer":   {[]InventoryItem{{Name: "Clothes, costume", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 15},
+		"folk hero":     {[]InventoryItem{{Name: "Shovel", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pot, iron", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+		"guild artisan": {[]InventoryItem{{Name: "Smith's Tools", Category: "Tools", Quantity: 1}, {Name: "Clothes, traveler's", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 15},
+		"hermit":        {[]InventoryItem{{Name: "Case, map or scroll", Category: "Adventuring Gear", Quantity: 1}, {Name: "Blanket", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Herbalism Kit", Category: "Adventuring Gear", Quantity: 1}}, 5},
+		"noble":         {[]InventoryItem{{Name: "Clothes, fine", Category: "Adventuring Gear", Quantity: 1}, {Name: "Signet ring", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 25},
+		"outlander":     {[]InventoryItem{{Name: "Staff", Category: "Adventuring Gear", Quantity: 1}, {Name: "Hunting trap", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, traveler's", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+		"sage":          {[]InventoryItem{{Name: "Ink (1 ounce bottle)", Category: "Adventuring Gear", Quantity: 1}, {Name: "Ink pen", Category: "Adventuring Gear", Quantity: 1}, {Name: "Small knife", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+		"sailor":        {[]InventoryItem{{Name: "Rope, silk (50 feet)", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+		"soldier":       {[]InventoryItem{{Name: "Dice Set", Category: "Tools", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+		"urchin":        {[]InventoryItem{{Name: "Small knife", Category: "Adventuring Gear", Quantity: 1}, {Name: "Clothes, common", Category: "Adventuring Gear", Quantity: 1}, {Name: "Pouch", Category: "Adventuring Gear", Quantity: 1}}, 10},
+	}
+
+	equipment := backgroundEquipment[strings.ToLower(b.Name)]
+	return equipment.Items, Purse{GP: equipment.Gold}
+}
+
+// AddStartingEquipment gives a new character the equipment and coins of their class and background
+// The items are carried but not equipped; weapons and armor still have to be equipped
+func (c *Character) AddStartingEquipment() {
+	items := NewClass(c.Class).GetStartingEquipment()
+	backgroundItems, coins := NewBackground(c.Background).GetStartingEquipment()
+	for _, item := range append(items, backgroundItems...) {
+		c.AddItem(item)
+	}
+	c.GiveCoins(coins)
+}
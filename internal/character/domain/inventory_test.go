@@ -0,0 +1,68 @@
+package domain
+
+import "testing"
+
+func TestPurse_Pay(t *testing.T) {
+	tests := []struct {
+		name        string
+		purse       Purse
+		cost        string
+		expected    Purse
+		expectedErr error
+	}{
+		{name: "Exact coins", purse: Purse{GP: 5, SP: 3}, cost: "2 gp 3 sp", expected: Purse{GP: 3}},
+		{name: "Change from gold", purse: Purse{GP: 1}, cost: "3cp", expected: Purse{SP: 9, CP: 7}},
+		{name: "Smallest coins first", purse: Purse{CP: 15, GP: 1}, cost: "1 sp", expected: Purse{CP: 5, GP: 1}},
+		{name: "Change from platinum", purse: Purse{PP: 1}, cost: "2 gp, 5 sp", expected: Purse{GP: 7, SP: 5}},
+		{name: "Not enough money", purse: Purse{SP: 9}, cost: "1 gp", expected: Purse{SP: 9}, expectedErr: ErrInsufficientFunds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, err := ParseCoins(tt.cost)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing %q: %v", tt.cost, err)
+			}
+			if err := tt.purse.Pay(cost); err != tt.expectedErr {
+				t.Fatalf("Expected error %v, got %v", tt.expectedErr, err)
+			}
+			if tt.purse != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, tt.purse)
+			}
+		})
+	}
+
+	if _, err := ParseCoins("ten gold"); err != ErrInvalidCoins {
+		t.Errorf("Expected ErrInvalidCoins, got %v", err)
+	}
+}
+
+func TestCharacter_Inventory(t *testing.T) {
+	char := &Character{Class: "fighter", Background: "soldier", Level: 1}
+	char.AddStartingEquipment()
+
+	if !char.HasItem("longsword") || char.Coins.GP != 10 {
+		t.Fatalf("Expected a fighter soldier to start with a longsword and 10 gp, got %v and %s", char.Inventory, char.Coins)
+	}
+
+	if err := char.Equip("Longsword", nil, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item := char.Inventory[char.findItem("Longsword")]; !item.Equipped {
+		t.Error("Expected the equipped longsword to be marked as equipped")
+	}
+
+	if _, err := char.RemoveItem("crossbow bolt", 25); err != ErrInvalidItemQuantity {
+		t.Errorf("Expected ErrInvalidItemQuantity, got %v", err)
+	}
+	if item, err := char.RemoveItem("crossbow bolt", 5); err != nil || item.Quantity != 15 {
+		t.Errorf("Expected 15 bolts left, got %+v (err %v)", item, err)
+	}
+
+	if _, err := char.RemoveItem("longsword", 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Weapon != "" || char.HasItem("longsword") {
+		t.Errorf("Expected removing the longsword to unequip it, weapon is %q", char.Weapon)
+	}
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxAttunedItems is the number of magic items a character can be attuned to at once (D&D 5e rules)
+const MaxAttunedItems = 3
+
+// MagicItem describes a magic item from the D&D 5e SRD and the modifiers the sheet applies for it
+type MagicItem struct {
+	Name             string
+	Rarity           string
+	Attunement       bool           // the item only works for a creature attuned to it
+	AttunementClass  string         // only a character with this class can attune to the item, e.g. "paladin"
+	ArmorClassBonus  int            // added to AC
+	Unarmored        bool           // the AC bonus only applies without armor or a shield
+	SavingThrowBonus int            // added to every saving throw
+	AttackBonus      int            // added to attack rolls made with the item, for magic weapons
+	DamageBonus      int            // added to damage rolls made with the item, for magic weapons
+	AbilityScores    map[string]int // scores the item sets, e.g. STR 19; no effect if the score is already higher
+}
+
+// magicItemCatalog contains the magic items the sheet applies, keyed by lowercase name
+// Magic weapons are also in the equipment catalog with the stats of their base weapon
+// Weapons, armor and shields with a plain "+1" to "+3" bonus are read from their name instead
+var magicItemCatalog = map[string]MagicItem{
+	"amulet of health":             {Name: "Amulet of Health", Rarity: "Rare", Attunement: true, AbilityScores: map[string]int{"CON": 19}},
+	"belt of hill giant strength":  {Name: "Belt of Hill Giant Strength", Rarity: "Rare", Attunement: true, AbilityScores: map[string]int{"STR": 21}},
+	"belt of stone giant strength": {Name: "Belt of Stone Giant Strength", Rarity: "Very Rare", Attunement: true, AbilityScores: map[string]int{"STR": 23}},
+	"belt of frost giant strength": {Name: "Belt of Frost Giant Strength", Rarity: "Very Rare", Attunement: true, AbilityScores: map[string]int{"STR": 23}},
+	"belt of fire giant strength":  {Name: "Belt of Fire Giant Strength", Rarity: "Very Rare", Attunement: true, AbilityScores: map[string]int{"STR": 25}},
+	"belt of cloud giant strength": {Name: "Belt of Cloud Giant Strength", Rarity: "Legendary", Attunement: true, AbilityScores: map[string]int{"STR": 27}},
+	"belt of storm giant strength": {Name: "Belt of Storm Giant Strength", Rarity: "Legendary", Attunement: true, AbilityScores: map[string]int{"STR": 29}},
+	"bracers of defense":           {Name: "Bracers of Defense", Rarity: "Rare", Attunement: true, ArmorClassBonus: 2, Unarmored: true},
+	"cloak of protection":          {Name: "Cloak of Protection", Rarity: "Uncommon", Attunement: true, ArmorClassBonus: 1, SavingThrowBonus: 1},
+	"defender":                     {Name: "Defender", Rarity: "Legendary", Attunement: true, AttackBonus: 3, DamageBonus: 3},
+	"gauntlets of ogre power":      {Name: "Gauntlets of Ogre Power", Rarity: "Uncommon", Attunement: true, AbilityScores: map[string]int{"STR": 19}},
+	"headband of intellect":        {Name: "Headband of Intellect", Rarity: "Uncommon", Attunement: true, AbilityScores: map[string]int{"INT": 19}},
+	"holy avenger":                 {Name: "Holy Avenger", Rarity: "Legendary", Attunement: true, AttunementClass: "paladin", AttackBonus: 3, DamageBonus: 3},
+	"luck blade":                   {Name: "Luck Blade", Rarity: "Legendary", Attunement: true, AttackBonus: 1, DamageBonus: 1, SavingThrowBonus: 1},
+	"ring of protection":           {Name: "Ring of Protection", Rarity: "Rare", Attunement: true, ArmorClassBonus: 1, SavingThrowBonus: 1},
+}
+
+// LookupMagicItem finds a magic item by name (case-insensitive)
+func LookupMagicItem(name string) (MagicItem, bool) {
+	item, ok := magicItemCatalog[strings.ToLower(strings.TrimSpace(name))]
+	return item, ok
+}
+
+// IsAttuned checks if the character is attuned to a magic item
+func (c *Character) IsAttuned(name string) bool {
+	for _, attuned := range c.Attuned {
+		if strings.EqualFold(attuned, strings.TrimSpace(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attune attunes the character to a carried magic item
+// D&D 5e rule: a creature can be attuned to no more than three magic items at a time
+func (c *Character) Attune(name string) (MagicItem, error) {
+	i := c.findItem(name)
+	if i < 0 {
+		return MagicItem{}, ErrItemNotCarried
+	}
+	item, ok := LookupMagicItem(c.Inventory[i].Name)
+	if !ok || !item.Attunement {
+		return MagicItem{}, fmt.Errorf("%w: %s", ErrNoAttunement, c.Inventory[i].Name)
+	}
+	if c.IsAttuned(item.Name) {
+		return item, fmt.Errorf("%w: %s", ErrAlreadyAttuned, item.Name)
+	}
+	if len(c.Attuned) >= MaxAttunedItems {
+		return item, ErrAttunementFull
+	}
+	if item.AttunementClass != "" && c.ClassLevel(item.AttunementClass) == 0 {
+		return item, fmt.Errorf("%w: %s requires attunement by a %s", ErrAttunementRestricted, item.Name, item.AttunementClass)
+	}
+
+	c.Attuned = append(c.Attuned, item.Name)
+	return item, nil
+}
+
+// Unattune ends the character's attunement to a magic item
+func (c *Character) Unattune(name string) (MagicItem, error) {
+	for i, attuned := range c.Attuned {
+		if strings.EqualFold(attuned, strings.TrimSpace(name)) {
+			c.Attuned = append(c.Attuned[:i], c.Attuned[i+1:]...)
+
+			// Losing an Amulet of Health can lower the hit point maximum
+			c.CurrentHP = min(c.CurrentHP, c.MaxHitPoints())
+
+			item, _ := LookupMagicItem(attuned)
+			return item, nil
+		}
+	}
+	return MagicItem{}, fmt.Errorf("%w: %s", ErrNotAttuned, name)
+}
+
+// activeMagicItems returns the catalog entries of the magic items whose modifiers currently apply:
+// items the character is attuned to, and carried items that don't need attunement
+func (c *Character) activeMagicItems() []MagicItem {
+	var active []MagicItem
+	for _, carried := range c.Inventory {
+		item, ok := LookupMagicItem(carried.Name)
+		if ok && (!item.Attunement || c.IsAttuned(item.Name)) {
+			active = append(active, item)
+		}
+	}
+	return active
+}
+
+// magicItemArmorClassBonus returns the AC bonus of the active magic items
+func (c *Character) magicItemArmorClassBonus() int {
+	bonus := 0
+	for _, item := range c.activeMagicItems() {
+		if item.Unarmored && (c.ArmorStats != nil || c.ShieldStats != nil) {
+			continue
+		}
+		bonus += item.ArmorClassBonus
+	}
+	return bonus
+}
+
+// magicItemSavingThrowBonus returns the saving throw bonus of the active magic items
+func (c *Character) magicItemSavingThrowBonus() int {
+	bonus := 0
+	for _, item := range c.activeMagicItems() {
+		bonus += item.SavingThrowBonus
+	}
+	return bonus
+}
+
+// magicWeaponBonus returns the attack and damage bonus of a wielded magic weapon such as a Defender
+func (c *Character) magicWeaponBonus(weapon WeaponStats) (int, int) {
+	item, ok := LookupMagicItem(weapon.Name)
+	if !ok || (item.Attunement && !c.IsAttuned(item.Name)) {
+		return 0, 0
+	}
+	return item.AttackBonus, item.DamageBonus
+}
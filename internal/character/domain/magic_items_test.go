@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCharacter_Attunement(t *testing.T) {
+	char := &Character{Race: "human", Class: "fighter", Level: 1, ProficiencyBonus: 2, Str: 10, Dex: 14, Con: 12, CurrentHP: 11}
+	for _, item := range []string{"Cloak of Protection", "Ring of Protection", "Gauntlets of Ogre Power", "Amulet of Health", "Holy Avenger", "Rope, hempen (50 feet)"} {
+		if _, err := char.AddItem(InventoryItem{Name: item, Quantity: 1}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// Carrying magic items does nothing until the character attunes to them
+	if char.ArmorClass() != 12 || char.AbilityScore("STR") != 10 {
+		t.Errorf("Expected no bonuses before attuning, got AC %d and Str %d", char.ArmorClass(), char.AbilityScore("STR"))
+	}
+	for _, item := range []string{"cloak of protection", "Ring of Protection", "Gauntlets of Ogre Power"} {
+		if _, err := char.Attune(item); err != nil {
+			t.Fatalf("Unexpected error attuning to %s: %v", item, err)
+		}
+	}
+	if char.ArmorClass() != 14 || char.SavingThrow("DEX") != 4 || char.AbilityScore("STR") != 19 || char.BaseAbilityScore("STR") != 10 {
+		t.Errorf("Expected +2 AC, +2 saves and Str 19, got AC %d, Dex save %d and Str %d", char.ArmorClass(), char.SavingThrow("DEX"), char.AbilityScore("STR"))
+	}
+	if attack := char.weaponAttack("club", nil, false); attack.AttackBonus != 4 {
+		t.Errorf("Expected the Str 19 modifier on attacks, got %+d", attack.AttackBonus)
+	}
+
+	if _, err := char.Attune("Amulet of Health"); !errors.Is(err, ErrAttunementFull) {
+		t.Errorf("Expected ErrAttunementFull, got %v", err)
+	}
+	if _, err := char.Attune("Rope, hempen (50 feet)"); !errors.Is(err, ErrNoAttunement) {
+		t.Errorf("Expected ErrNoAttunement, got %v", err)
+	}
+
+	if _, err := char.Unattune("Gauntlets of Ogre Power"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := char.Attune("Holy Avenger"); !errors.Is(err, ErrAttunementRestricted) {
+		t.Errorf("Expected a fighter not to attune to a Holy Avenger, got %v", err)
+	}
+
+	// Removing an item also ends the attunement
+	if _, err := char.RemoveItem("Ring of Protection", 1); err != nil || char.IsAttuned("Ring of Protection") {
+		t.Errorf("Expected removing the ring to end attunement, got %v (err %v)", char.Attuned, err)
+	}
+}
+
+func TestCharacter_MagicWeaponAttack(t *testing.T) {
+	defender := &WeaponStats{Name: "Defender", Category: "Martial", Range: "Melee", Damage: "1d8", DamageType: "Slashing"}
+	char := &Character{Class: "fighter", Level: 1, ProficiencyBonus: 2, Str: 16, Weapon: "Defender", WeaponStats: defender}
+	if _, err := char.AddItem(InventoryItem{Name: "Defender", Quantity: 1}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := char.WeaponAttacks()[0].String(); got != "Defender: +5 to hit, 1d8+3 slashing, 5 ft" {
+		t.Errorf("Expected no bonus before attuning, got %q", got)
+	}
+	if _, err := char.Attune("Defender"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := char.WeaponAttacks()[0].String(); got != "Defender: +8 to hit, 1d8+6 slashing, 5 ft" {
+		t.Errorf("Expected the attuned Defender's +3, got %q", got)
+	}
+}
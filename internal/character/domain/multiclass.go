@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxCharacterLevel is the highest total character level (D&D 5e rules)
+const MaxCharacterLevel = 20
+
+// ClassLevel is the number of levels a character has taken in a single class
+type ClassLevel struct {
+	Class    string `json:"class"`
+	Level    int    `json:"level"`
+	Subclass string `json:"subclass,omitempty"`
+}
+
+// LevelUpResult describes what a class level change gave the character
+type LevelUpResult struct {
+	Features      []ClassFeature // class and subclass features gained
+	ASIsGained    int            // new Ability Score Improvements to choose
+	NeedsSubclass bool           // the class reached its subclass level without a subclass
+}
+
+// multiclassPrerequisites lists the ability scores needed to multiclass into or out of a class
+// Each inner slice is a set of abilities that must all be 13 or higher; meeting any one set is enough
+var multiclassPrerequisites = map[string][][]string{
+	"barbarian": {{"STR"}},
+	"bard":      {{"CHA"}},
+	"cleric":    {{"WIS"}},
+	"druid":     {{"WIS"}},
+	"fighter":   {{"STR"}, {"DEX"}},
+	"monk":      {{"DEX", "WIS"}},
+	"paladin":   {{"STR", "CHA"}},
+	"ranger":    {{"DEX", "WIS"}},
+	"rogue":     {{"DEX"}},
+	"sorcerer":  {{"CHA"}},
+	"warlock":   {{"CHA"}},
+	"wizard":    {{"INT"}},
+	"artificer": {{"INT"}},
+}
+
+// multiclassMinimumScore is the ability score a multiclass prerequisite requires
+const multiclassMinimumScore = 13
+
+// ClassLevels returns the levels the character has in each class, primary class first
+// Characters that haven't changed level or chosen a subclass since creation only have Class and Level,
+// which count as a single entry
+func (c *Character) ClassLevels() []ClassLevel {
+	if len(c.Classes) > 0 {
+		return c.Classes
+	}
+	return []ClassLevel{{Class: c.Class, Level: c.Level}}
+}
+
+// ClassLevel returns the character's level in a class, 0 if they have no levels in it
+func (c *Character) ClassLevel(class string) int {
+	for _, cl := range c.ClassLevels() {
+		if strings.EqualFold(cl.Class, class) {
+			return cl.Level
+		}
+	}
+	return 0
+}
+
+// IsMulticlassed returns true if the character has levels in more than one class
+func (c *Character) IsMulticlassed() bool {
+	return len(c.ClassLevels()) > 1
+}
+
+// ClassSummary describes the character's classes and subclasses, e.g. "fighter (Champion)" or "fighter 3 / wizard 2"
+func (c *Character) ClassSummary() string {
+	classes := c.ClassLevels()
+	parts := make([]string, 0, len(classes))
+	for _, cl := range classes {
+		part := cl.Class
+		if c.IsMulticlassed() {
+			part = fmt.Sprintf("%s %d", cl.Class, cl.Level)
+		}
+		if cl.Subclass != "" {
+			part += " (" + cl.Subclass + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " / ")
+}
+
+// MeetsMulticlassPrerequisites checks the ability score prerequisites of a class
+// Classes without known prerequisites are always allowed
+func (c *Character) MeetsMulticlassPrerequisites(class string) bool {
+	options, ok := multiclassPrerequisites[strings.ToLower(class)]
+	if !ok {
+		return true
+	}
+
+	for _, abilities := range options {
+		met := true
+		for _, ability := range abilities {
+			if c.AbilityScore(ability) < multiclassMinimumScore {
+				met = false
+				break
+			}
+		}
+		if met {
+			return true
+		}
+	}
+	return false
+}
+
+// SetClassLevel sets the character's level in a class, adding the class if they don't have it yet,
+// and recalculates everything that depends on class levels
+// D&D 5e rule: multiclassing requires meeting the prerequisites of both the current classes and the new one
+func (c *Character) SetClassLevel(class string, level int) (LevelUpResult, error) {
+	var result LevelUpResult
+
+	classes := append([]ClassLevel(nil), c.ClassLevels()...)
+
+	index := -1
+	total := level
+	for i, cl := range classes {
+		if strings.EqualFold(cl.Class, class) {
+			index = i
+		} else {
+			total += cl.Level
+		}
+	}
+	if level < 1 || total > MaxCharacterLevel {
+		return result, ErrInvalidLevel
+	}
+	oldClassLevel := c.ClassLevel(class)
+	oldMaxHP := c.MaxHitPoints()
+
+	if index < 0 {
+		for _, cl := range append(classes, ClassLevel{Class: class}) {
+			if !c.MeetsMulticlassPrerequisites(cl.Class) {
+				return result, fmt.Errorf("%w for %s", ErrMulticlassPrerequisites, strings.ToLower(cl.Class))
+			}
+		}
+		classes = append(classes, ClassLevel{Class: strings.ToLower(class), Level: level})
+	} else {
+		classes[index].Level = level
+		class = classes[index].Class
+	}
+
+	c.Classes = classes
+	c.Level = total
+	c.ProficiencyBonus = ProficiencyBonus(total)
+	c.SpellSlots = c.GetSpellSlots()
+	c.refreshPactSlots()
+	c.HitDice.Resize(c.ClassLevels())
+
+	// New class levels can grant Ability Score Improvements; levels lost take back unspent ones
+	if level > oldClassLevel {
+		result.ASIsGained = abilityScoreImprovements(class, oldClassLevel, level)
+		c.PendingASI += result.ASIsGained
+	} else {
+		c.PendingASI = max(0, c.PendingASI-abilityScoreImprovements(class, level, oldClassLevel))
+	}
+
+	// Hit points gained (or lost) by the level change apply to current HP as well
+	c.applyMaxHitPointChange(oldMaxHP)
+	c.RefreshResources()
+
+	subclass := c.Subclass(class)
+	result.Features = classFeatures(class, subclass, oldClassLevel, level)
+	result.NeedsSubclass = subclass == "" && level >= SubclassLevel(class) && len(Subclasses(class)) > 0
+
+	return result, nil
+}
+
+// spellcastingProgression returns how a class gains spell slots: "full", "half", "third", "pact" or "" for non-casters
+// The Eldritch Knight and Arcane Trickster subclasses are third casters
+func spellcastingProgression(class string) string {
+	switch strings.ToLower(class) {
+	case "wizard", "cleric", "druid", "bard", "sorcerer":
+		return "full"
+	case "paladin", "ranger":
+		return "half"
+	case "eldritch knight", "arcane trickster":
+		return "third"
+	case "warlock":
+		return "pact"
+	default:
+		return ""
+	}
+}
+
+// multiclassCasterLevel returns the caster level used with the multiclass spellcaster table
+// D&D 5e rule: all levels in full casters plus half the levels (rounded down) in paladin and ranger, and a third of
+// the levels (rounded down) in Eldritch Knight fighter and Arcane Trickster rogue
+func (c *Character) multiclassCasterLevel() int {
+	casterLevel := 0
+	for _, cl := range c.ClassLevels() {
+		switch spellcastingProgression(casterKey(cl)) {
+		case "full":
+			casterLevel += cl.Level
+		case "half":
+			casterLevel += cl.Level / 2
+		case "third":
+			casterLevel += cl.Level / 3
+		}
+	}
+	return casterLevel
+}
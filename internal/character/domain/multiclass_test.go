@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCharacter_MulticlassSpellSlots(t *testing.T) {
+	tests := []struct {
+		name          string
+		classes       []ClassLevel
+		expectedSlots map[int]int
+		expectedPact  map[int]int
+	}{
+		{
+			name:          "Single half caster uses its own table",
+			classes:       []ClassLevel{{Class: "paladin", Level: 5}},
+			expectedSlots: map[int]int{1: 4, 2: 2},
+		},
+		{
+			name:          "Full and half caster combine",
+			classes:       []ClassLevel{{Class: "wizard", Level: 3}, {Class: "paladin", Level: 5}},
+			expectedSlots: map[int]int{0: 3, 1: 4, 2: 3, 3: 2},
+		},
+		{
+			name:          "Pact Magic stays separate",
+			classes:       []ClassLevel{{Class: "sorcerer", Level: 3}, {Class: "warlock", Level: 3}},
+			expectedSlots: map[int]int{0: 6, 1: 4, 2: 2},
+			expectedPact:  map[int]int{2: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Class: tt.classes[0].Class, Classes: tt.classes}
+
+			slots := char.GetSpellSlots()
+			if len(slots) != len(tt.expectedSlots) {
+				t.Errorf("Expected slots %v, got %v", tt.expectedSlots, slots)
+			}
+			for level, expected := range tt.expectedSlots {
+				if slots[level] != expected {
+					t.Errorf("Expected %d level %d slots, got %d", expected, level, slots[level])
+				}
+			}
+
+			pact := char.GetPactSlots()
+			if len(pact) != len(tt.expectedPact) {
+				t.Errorf("Expected pact slots %v, got %v", tt.expectedPact, pact)
+			}
+			for level, expected := range tt.expectedPact {
+				if pact[level] != expected {
+					t.Errorf("Expected %d level %d pact slots, got %d", expected, level, pact[level])
+				}
+			}
+		})
+	}
+}
+
+func TestCharacter_SetClassLevel(t *testing.T) {
+	char := NewCharacter("Test", "human", "fighter", 3, 14, 12, 14, 10, 10, 10, "soldier", nil)
+
+	if _, err := char.SetClassLevel("wizard", 1); !errors.Is(err, ErrMulticlassPrerequisites) {
+		t.Errorf("Expected ErrMulticlassPrerequisites with Int 10, got %v", err)
+	}
+
+	char.Int = 13
+	if _, err := char.SetClassLevel("wizard", 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.Level != 5 || char.ProficiencyBonus != 3 || char.ClassSummary() != "fighter 3 / wizard 2" {
+		t.Errorf("Expected fighter 3 / wizard 2 at level 5, got %s at level %d", char.ClassSummary(), char.Level)
+	}
+
+	// Fighter 3 (10 + 6 + 6) + wizard 2 (4 + 4) + Con +2 per level = 40
+	if hp := char.MaxHitPoints(); hp != 40 {
+		t.Errorf("Expected 40 max HP, got %d", hp)
+	}
+	if char.HitDice.String() != "3d10 + 2d6" || char.HitDice.Remaining() != 5 {
+		t.Errorf("Expected 3d10 + 2d6 hit dice, got %s (%d remaining)", char.HitDice, char.HitDice.Remaining())
+	}
+
+	if _, err := char.SetClassLevel("wizard", 18); !errors.Is(err, ErrInvalidLevel) {
+		t.Errorf("Expected ErrInvalidLevel above level 20, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GetWeaponProficiencies returns the weapons this class is proficient with according to D&D 5e rules
+// Entries are "simple", "martial" or the catalog name of a specific weapon
+func (cl *Class) GetWeaponProficiencies() []string {
+	classWeapons := map[string][]string{
+		"barbarian": {"simple", "martial"},
+		"bard":      {"simple", "crossbow, hand", "longsword", "rapier", "shortsword"},
+		"cleric":    {"simple"},
+		"druid":     {"club", "dagger", "dart", "javelin", "mace", "quarterstaff", "scimitar", "sickle", "sling", "spear"},
+		"fighter":   {"simple", "martial"},
+		"monk":      {"simple", "shortsword"},
+		"paladin":   {"simple", "martial"},
+		"ranger":    {"simple", "martial"},
+		"rogue":     {"simple", "crossbow, hand", "longsword", "rapier", "shortsword"},
+		"sorcerer":  {"dagger", "dart", "sling", "quarterstaff", "crossbow, light"},
+		"warlock":   {"simple"},
+		"wizard":    {"dagger", "dart", "sling", "quarterstaff", "crossbow, light"},
+	}
+
+	return classWeapons[strings.ToLower(cl.Name)]
+}
+
+// GetArmorProficiencies returns the armor categories this class is proficient with according to D&D 5e rules
+// Entries are "light", "medium", "heavy" or "shield"
+func (cl *Class) GetArmorProficiencies() []string {
+	classArmor := map[string][]string{
+		"barbarian": {"light", "medium", "shield"},
+		"bard":      {"light"},
+		"cleric":    {"light", "medium", "shield"},
+		"druid":     {"light", "medium", "shield"},
+		"fighter":   {"light", "medium", "heavy", "shield"},
+		"monk":      {},
+		"paladin":   {"light", "medium", "heavy", "shield"},
+		"ranger":    {"light", "medium", "shield"},
+		"rogue":     {"light"},
+		"sorcerer":  {},
+		"warlock":   {"light"},
+		"wizard":    {},
+	}
+
+	return classArmor[strings.ToLower(cl.Name)]
+}
+
+// GetMulticlassWeaponProficiencies returns the weapons a character gains proficiency with by multiclassing into this
+// class, according to the D&D 5e multiclassing rules
+func (cl *Class) GetMulticlassWeaponProficiencies() []string {
+	multiclassWeapons := map[string][]string{
+		"barbarian": {"simple", "martial"},
+		"fighter":   {"simple", "martial"},
+		"monk":      {"simple", "shortsword"},
+		"paladin":   {"simple", "martial"},
+		"ranger":    {"simple", "martial"},
+		"warlock":   {"simple"},
+	}
+
+	return multiclassWeapons[strings.ToLower(cl.Name)]
+}
+
+// GetMulticlassArmorProficiencies returns the armor categories a character gains proficiency with by multiclassing
+// into this class, according to the D&D 5e multiclassing rules
+func (cl *Class) GetMulticlassArmorProficiencies() []string {
+	multiclassArmor := map[string][]string{
+		"barbarian": {"shield"},
+		"bard":      {"light"},
+		"cleric":    {"light", "medium", "shield"},
+		"druid":     {"light", "medium", "shield"},
+		"fighter":   {"light", "medium", "shield"},
+		"paladin":   {"light", "medium", "shield"},
+		"ranger":    {"light", "medium", "shield"},
+		"rogue":     {"light"},
+		"warlock":   {"light"},
+	}
+
+	return multiclassArmor[strings.ToLower(cl.Name)]
+}
+
+// weaponProficiencies returns the weapon proficiencies from the character's classes and race
+// D&D 5e multiclassing rule: only the first class grants its full starting proficiencies
+func (c *Character) weaponProficiencies() []string {
+	proficiencies := append([]string{}, NewRace(c.Race).WeaponProficiencies...)
+	for i, cl := range c.ClassLevels() {
+		if i == 0 {
+			proficiencies = append(proficiencies, NewClass(cl.Class).GetWeaponProficiencies()...)
+		} else {
+			proficiencies = append(proficiencies, NewClass(cl.Class).GetMulticlassWeaponProficiencies()...)
+		}
+	}
+	return proficiencies
+}
+
+// armorProficiencies returns the armor proficiencies from the character's classes, subclass features and race
+// D&D 5e multiclassing rule: only the first class grants its full starting proficiencies
+func (c *Character) armorProficiencies() []string {
+	proficiencies := append([]string{}, NewRace(c.Race).ArmorProficiencies...)
+	for i, cl := range c.ClassLevels() {
+		if i == 0 {
+			proficiencies = append(proficiencies, NewClass(cl.Class).GetArmorProficiencies()...)
+		} else {
+			proficiencies = append(proficiencies, NewClass(cl.Class).GetMulticlassArmorProficiencies()...)
+		}
+	}
+	for _, f := range c.Features() {
+		proficiencies = append(proficiencies, f.ArmorProficiencies...)
+	}
+	return proficiencies
+}
+
+// IsProficientWithWeapon checks if the character's classes or race grant proficiency with a weapon
+func (c *Character) IsProficientWithWeapon(weapon WeaponStats) bool {
+	for _, p := range c.weaponProficiencies() {
+		if strings.EqualFold(p, weapon.Category) || strings.EqualFold(p, weapon.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsProficientWithArmor checks if the character's classes or race grant proficiency with armor or a shield
+func (c *Character) IsProficientWithArmor(armor ArmorStats) bool {
+	for _, p := range c.armorProficiencies() {
+		if strings.EqualFold(p, armor.Category) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnproficientArmor returns the worn armor and shield the character isn't proficient with
+func (c *Character) UnproficientArmor() []string {
+	var names []string
+	if c.ArmorStats != nil && !c.IsProficientWithArmor(*c.ArmorStats) {
+		names = append(names, c.Armor)
+	}
+	if c.ShieldStats != nil && !c.IsProficientWithArmor(*c.ShieldStats) {
+		names = append(names, c.Shield)
+	}
+	return names
+}
+
+// ArmorProficiencyWarning describes the penalties for wearing armor without proficiency, or "" if there are none
+// D&D 5e rule: disadvantage on ability checks, saving throws and attack rolls that involve Str or Dex, and no spellcasting
+func (c *Character) ArmorProficiencyWarning() string {
+	names := c.UnproficientArmor()
+	if len(names) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Not proficient with %s: disadvantage on Str and Dex checks, saves and attack rolls, and can't cast spells",
+		strings.Join(names, " and "))
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "testing"
+
+func TestCharacter_Proficiencies(t *testing.T) {
+	longbow := WeaponStats{Name: "Longbow", Category: "Martial", Range: "Ranged"}
+	chainMail := ArmorStats{Name: "Chain Mail", Category: "Heavy", BaseAC: 16}
+
+	elf := &Character{Race: "elf", Class: "wizard", Level: 1}
+	if !elf.IsProficientWithWeapon(longbow) {
+		t.Error("Expected an elf wizard to be proficient with the longbow")
+	}
+	human := &Character{Race: "human", Class: "wizard", Level: 1}
+	if human.IsProficientWithWeapon(longbow) {
+		t.Error("Expected a human wizard not to be proficient with the longbow")
+	}
+
+	if err := human.WearArmor(chainMail); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if human.ArmorProficiencyWarning() == "" || human.AttackRollMode().String() != "disadvantage" || human.SavingThrowMode("DEX").String() != "disadvantage" {
+		t.Error("Expected armor without proficiency to impose disadvantage on attacks and Dex saves")
+	}
+	if human.AbilityCheckMode("STR").String() != "disadvantage" || human.AbilityCheckMode("WIS").String() != "" {
+		t.Error("Expected armor without proficiency to impose disadvantage on Str checks only")
+	}
+	if err := human.CastSpell(0); err != ErrArmorPreventsSpellcasting {
+		t.Errorf("Expected ErrArmorPreventsSpellcasting, got %v", err)
+	}
+
+	fighter := &Character{Race: "human", Class: "fighter", Level: 1}
+	if err := fighter.WearArmor(chainMail); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fighter.ArmorProficiencyWarning() != "" || fighter.SavingThrowMode("DEX").String() != "" {
+		t.Error("Expected a fighter to wear chain mail without penalties")
+	}
+}
+
+func TestCharacter_MulticlassProficiencies(t *testing.T) {
+	plate := ArmorStats{Name: "Plate", Category: "Heavy", BaseAC: 18}
+	longsword := WeaponStats{Name: "Longsword", Category: "Martial", Range: "Melee"}
+
+	tests := []struct {
+		name      string
+		classes   []ClassLevel
+		heavy     bool
+		longsword bool
+	}{
+		{"fighter first keeps heavy armor", []ClassLevel{{Class: "fighter", Level: 1}, {Class: "wizard", Level: 1}}, true, true},
+		{"fighter multiclass gains no heavy armor", []ClassLevel{{Class: "wizard", Level: 1}, {Class: "fighter", Level: 1}}, false, true},
+		{"rogue multiclass gains no longsword", []ClassLevel{{Class: "cleric", Level: 1}, {Class: "rogue", Level: 1}}, false, false},
+		{"life domain gains heavy armor", []ClassLevel{{Class: "cleric", Level: 1, Subclass: "Life Domain"}}, true, false},
+		{"life domain as a multiclass", []ClassLevel{{Class: "wizard", Level: 1}, {Class: "cleric", Level: 1, Subclass: "Life Domain"}}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Race: "human", Class: tt.classes[0].Class, Classes: tt.classes}
+			if char.IsProficientWithArmor(plate) != tt.heavy {
+				t.Errorf("Expected heavy armor proficiency %v", tt.heavy)
+			}
+			if char.IsProficientWithWeapon(longsword) != tt.longsword {
+				t.Errorf("Expected longsword proficiency %v", tt.longsword)
+			}
+		})
+	}
+}
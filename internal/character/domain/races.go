@@ -0,0 +1,171 @@
+package domain
+
+import "strings"
+
+// RacialTrait is a named racial feature such as Darkvision or Fey Ancestry
+type RacialTrait struct {
+	Name        string
+	Description string
+}
+
+// Traits shared by several races
+var (
+	traitDarkvision        = RacialTrait{"Darkvision", "See in dim light within 60 feet as if it were bright light, and in darkness as if it were dim light"}
+	traitFeyAncestry       = RacialTrait{"Fey Ancestry", "Advantage on saving throws against being charmed, and magic can't put you to sleep"}
+	traitDwarvenResilience = RacialTrait{"Dwarven Resilience", "Advantage on saving throws against poison, and resistance against poison damage"}
+	traitDwarvenTraining   = RacialTrait{"Dwarven Combat Training", "Proficiency with the battleaxe, handaxe, light hammer, and warhammer"}
+	traitStonecunning      = RacialTrait{"Stonecunning", "Double proficiency bonus on History checks related to the origin of stonework"}
+	traitLucky             = RacialTrait{"Lucky", "When you roll a 1 on an attack roll, ability check, or saving throw, you can reroll the die"}
+	traitBrave             = RacialTrait{"Brave", "Advantage on saving throws against being frightened"}
+	traitNimbleness        = RacialTrait{"Halfling Nimbleness", "You can move through the space of any creature that is of a size larger than yours"}
+)
+
+// dwarfWeapons are the weapons Dwarven Combat Training grants proficiency with
+var dwarfWeapons = []string{"battleaxe", "handaxe", "light hammer", "warhammer"}
+
+// dwarfTraits are shared by all dwarf subraces
+var dwarfTraits = []RacialTrait{traitDarkvision, traitDwarvenResilience, traitDwarvenTraining, traitStonecunning}
+
+// halflingTraits are shared by all halfling subraces
+var halflingTraits = []RacialTrait{traitLucky, traitBrave, traitNimbleness}
+
+// raceData holds the D&D 5e SRD racial traits, keyed by normalised race name
+var raceData = map[string]Race{
+	"dwarf": {
+		Speed: 25, Size: "Medium", Darkvision: 60,
+		Languages:                     []string{"Common", "Dwarvish"},
+		Traits:                        dwarfTraits,
+		IgnoresHeavyArmorSpeedPenalty: true,
+		WeaponProficiencies:           dwarfWeapons,
+	},
+	"hill dwarf": {
+		Speed: 25, Size: "Medium", Darkvision: 60,
+		Languages: []string{"Common", "Dwarvish"},
+		Traits: append(append([]RacialTrait{}, dwarfTraits...),
+			RacialTrait{"Dwarven Toughness", "Hit point maximum increases by 1 per level"}),
+		IgnoresHeavyArmorSpeedPenalty: true,
+		WeaponProficiencies:           dwarfWeapons,
+	},
+	"elf": {
+		Speed: 30, Size: "Medium", Darkvision: 60,
+		Languages: []string{"Common", "Elvish"},
+		Traits: []RacialTrait{
+			traitDarkvision,
+			{"Keen Senses", "Proficiency in the Perception skill"},
+			traitFeyAncestry,
+			{"Trance", "Meditate deeply for 4 hours instead of sleeping"},
+			{"Elf Weapon Training", "Proficiency with the longsword, shortsword, shortbow, and longbow"},
+		},
+		WeaponProficiencies: []string{"longsword", "shortsword", "shortbow", "longbow"},
+		SkillProficiencies:  []string{"perception"},
+	},
+	"halfling": {
+		Speed: 25, Size: "Small",
+		Languages: []string{"Common", "Halfling"},
+		Traits:    halflingTraits,
+	},
+	"lightfoot halfling": {
+		Speed: 25, Size: "Small",
+		Languages: []string{"Common", "Halfling"},
+		Traits: append(append([]RacialTrait{}, halflingTraits...),
+			RacialTrait{"Naturally Stealthy", "You can attempt to hide when obscured only by a creature at least one size larger"}),
+	},
+	"stout halfling": {
+		Speed: 25, Size: "Small",
+		Languages: []string{"Common", "Halfling"},
+		Traits: append(append([]RacialTrait{}, halflingTraits...),
+			RacialTrait{"Stout Resilience", "Advantage on saving throws against poison, and resistance against poison damage"}),
+	},
+	"human": {
+		Speed: 30, Size: "Medium",
+		Languages: []string{"Common", "one extra language of your choice"},
+	},
+	"dragonborn": {
+		Speed: 30, Size: "Medium",
+		Languages: []string{"Common", "Draconic"},
+		Traits: []RacialTrait{
+			{"Draconic Ancestry", "Your dragon type determines your breath weapon and damage resistance"},
+			{"Breath Weapon", "Exhale destructive energy determined by your draconic ancestry"},
+			{"Damage Resistance", "Resistance to the damage type associated with your draconic ancestry"},
+		},
+	},
+	"gnome": {
+		Speed: 25, Size: "Small", Darkvision: 60,
+		Languages: []string{"Common", "Gnomish"},
+		Traits: []RacialTrait{
+			traitDarkvision,
+			{"Gnome Cunning", "Advantage on Intelligence, Wisdom, and Charisma saving throws against magic"},
+		},
+	},
+	"half elf": {
+		Speed: 30, Size: "Medium", Darkvision: 60,
+		Languages: []string{"Common", "Elvish", "one extra language of your choice"},
+		Traits: []RacialTrait{
+			traitDarkvision,
+			traitFeyAncestry,
+			{"Skill Versatility", "Proficiency in two skills of your choice"},
+		},
+	},
+	"half orc": {
+		Speed: 30, Size: "Medium", Darkvision: 60,
+		Languages: []string{"Common", "Orc"},
+		Traits: []RacialTrait{
+			traitDarkvision,
+			{"Menacing", "Proficiency in the Intimidation skill"},
+			{"Relentless Endurance", "When reduced to 0 hit points but not killed outright, drop to 1 hit point instead (once per long rest)"},
+			{"Savage Attacks", "Roll one extra weapon damage die when you score a critical hit with a melee weapon"},
+		},
+		SkillProficiencies: []string{"intimidation"},
+	},
+	"tiefling": {
+		Speed: 30, Size: "Medium", Darkvision: 60,
+		Languages: []string{"Common", "Infernal"},
+		Traits: []RacialTrait{
+			traitDarkvision,
+			{"Hellish Resistance", "Resistance to fire damage"},
+			{"Infernal Legacy", "You know the thaumaturgy cantrip, and later hellish rebuke and darkness"},
+		},
+	},
+}
+
+// raceKey normalises a race name so "Half-Orc" and "half orc" match the same entry
+func raceKey(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", " ")
+}
+
+// Speed calculates the character's walking speed in feet
+// D&D 5e rule: heavy armor without the required Strength reduces speed by 10 feet (dwarves are exempt)
+func (c *Character) Speed() int {
+	race := NewRace(c.Race)
+	speed := race.Speed
+
+	if c.ArmorStats != nil && c.AbilityScore("STR") < c.ArmorStats.StrMinimum && !race.IgnoresHeavyArmorSpeedPenalty {
+		speed -= 10
+	}
+
+	// Feats such as Mobile
+	speed += c.featBonus(func(f Feat) int { return f.SpeedBonus })
+
+	// Class features such as Fast Movement
+	speed += c.featureSpeedBonus()
+
+	// Carrying too much slows the character down
+	speed = c.encumbranceSpeed(speed)
+
+	// Conditions such as grappled and exhaustion reduce speed last
+	return c.conditionSpeed(speed)
+}
+
+// featureSpeedBonus returns the extra speed class features give
+// D&D 5e rules: Fast Movement gives +10 ft without heavy armor; Unarmored Movement gives +10 ft at monk level 2,
+// rising by 5 ft every 4 monk levels, without armor or a shield
+func (c *Character) featureSpeedBonus() int {
+	bonus := 0
+	if c.HasFeature("Fast Movement") && (c.ArmorStats == nil || c.ArmorStats.Category != "Heavy") {
+		bonus += 10
+	}
+	if c.HasFeature("Unarmored Movement") && c.ArmorStats == nil && c.ShieldStats == nil {
+		bonus += 10 + 5*((c.ClassLevel("monk")-2)/4)
+	}
+	return bonus
+}
@@ -0,0 +1,109 @@
+package domain
+
+import "testing"
+
+func TestNewRace(t *testing.T) {
+	tests := []struct {
+		race       string
+		speed      int
+		size       string
+		darkvision int
+		trait      string
+	}{
+		{"dwarf", 25, "Medium", 60, "Dwarven Resilience"},
+		{"Hill Dwarf", 25, "Medium", 60, "Dwarven Toughness"},
+		{"elf", 30, "Medium", 60, "Fey Ancestry"},
+		{"lightfoot halfling", 25, "Small", 0, "Naturally Stealthy"},
+		{"Half-Orc", 30, "Medium", 60, "Relentless Endurance"},
+		{"human", 30, "Medium", 0, ""},
+		{"warforged", 30, "Medium", 0, ""}, // unknown races fall back to the defaults
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.race, func(t *testing.T) {
+			race := NewRace(tt.race)
+			if race.Speed != tt.speed || race.Size != tt.size || race.Darkvision != tt.darkvision {
+				t.Errorf("Expected speed %d, %s, darkvision %d, got speed %d, %s, darkvision %d",
+					tt.speed, tt.size, tt.darkvision, race.Speed, race.Size, race.Darkvision)
+			}
+
+			found := false
+			for _, trait := range race.Traits {
+				found = found || trait.Name == tt.trait
+			}
+			if tt.trait != "" && !found {
+				t.Errorf("Expected trait %s, got %v", tt.trait, race.Traits)
+			}
+			if tt.trait == "" && len(race.Traits) > 0 {
+				t.Errorf("Expected no traits, got %v", race.Traits)
+			}
+		})
+	}
+}
+
+func TestRace_GetAbilityBonuses(t *testing.T) {
+	tests := []struct {
+		race     string
+		expected map[string]int
+	}{
+		{"hill dwarf", map[string]int{"con": 2, "wis": 1}},
+		{"Half-Elf", map[string]int{"cha": 2}},
+		{"tiefling", map[string]int{"int": 1, "cha": 2}},
+		{"human", map[string]int{"str": 1, "dex": 1, "con": 1, "int": 1, "wis": 1, "cha": 1}},
+		{"warforged", map[string]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.race, func(t *testing.T) {
+			bonuses := NewRace(tt.race).GetAbilityBonuses()
+			if len(bonuses) != len(tt.expected) {
+				t.Errorf("Expected bonuses %v, got %v", tt.expected, bonuses)
+			}
+			for ability, bonus := range tt.expected {
+				if bonuses[ability] != bonus {
+					t.Errorf("Expected %s +%d, got +%d", ability, bonus, bonuses[ability])
+				}
+			}
+		})
+	}
+}
+
+func TestRace_SkillProficiencies(t *testing.T) {
+	tests := []struct {
+		race     string
+		expected []string
+	}{
+		{"elf", []string{"perception"}},
+		{"Half-Orc", []string{"intimidation"}},
+		{"dwarf", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.race, func(t *testing.T) {
+			skills := NewRace(tt.race).SkillProficiencies
+			if len(skills) != len(tt.expected) || (len(skills) > 0 && skills[0] != tt.expected[0]) {
+				t.Errorf("Expected skill proficiencies %v, got %v", tt.expected, skills)
+			}
+		})
+	}
+}
+
+func TestCharacter_Speed_HeavyArmor(t *testing.T) {
+	plate := &ArmorStats{Name: "Plate", Category: "Heavy", BaseAC: 18, StrMinimum: 15}
+
+	human := &Character{Race: "human", Class: "fighter", Level: 1, Str: 13, ArmorStats: plate}
+	if human.Speed() != 20 {
+		t.Errorf("Expected plate without Str 15 to slow a human to 20 ft, got %d", human.Speed())
+	}
+
+	human.Str = 15
+	if human.Speed() != 30 {
+		t.Errorf("Expected no penalty with Str 15, got %d", human.Speed())
+	}
+
+	// Dwarves aren't slowed by heavy armor
+	dwarf := &Character{Race: "dwarf", Class: "fighter", Level: 1, Str: 13, ArmorStats: plate}
+	if dwarf.Speed() != 25 {
+		t.Errorf("Expected a dwarf to keep 25 ft in plate, got %d", dwarf.Speed())
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+import "strings"
+
+// RechargeType describes when a limited-use resource is regained
+type RechargeType string
+
+// Recharge types (D&D 5e rules)
+const (
+	RechargeShortRest RechargeType = "short rest"
+	RechargeLongRest  RechargeType = "long rest"
+	RechargeDawn      RechargeType = "dawn"
+)
+
+// Resource is a limited-use class feature such as Rage or Ki points
+type Resource struct {
+	Name     string       `json:"name"`
+	Max      int          `json:"max"`
+	Current  int          `json:"current"`
+	Recharge RechargeType `json:"recharge"`
+}
+
+// resourceDefinition describes a resource a class (or feat) grants and how its maximum scales
+type resourceDefinition struct {
+	Class    string // empty for resources that don't come from a class
+	Feat     string // feat that grants the resource, if any
+	Name     string
+	Level    int // class level the resource becomes available
+	Recharge RechargeType
+	Max      func(c *Character, classLevel int) int
+}
+
+// byLevel returns a maximum from a table of class level thresholds, e.g. Rage uses per day
+func byLevel(classLevel int, thresholds map[int]int) int {
+	best, maxUses := 0, 0
+	for level, uses := range thresholds {
+		if classLevel >= level && level >= best {
+			best, maxUses = level, uses
+		}
+	}
+	return maxUses
+}
+
+// resourceDefinitions lists the D&D 5e class resources the character sheet tracks
+var resourceDefinitions = []resourceDefinition{
+	{Class: "barbarian", Name: "Rage", Level: 1, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		// Unlimited at 20th level is tracked as 6 uses
+		return byLevel(lvl, map[int]int{1: 2, 3: 3, 6: 4, 12: 5, 17: 6})
+	}},
+	{Class: "bard", Name: "Bardic Inspiration", Level: 1, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return max(1, Modifier(c.AbilityScore("CHA")))
+	}},
+	{Class: "cleric", Name: "Channel Divinity", Level: 2, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return byLevel(lvl, map[int]int{2: 1, 6: 2, 18: 3})
+	}},
+	{Class: "druid", Name: "Wild Shape", Level: 2, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return 2
+	}},
+	{Class: "fighter", Name: "Second Wind", Level: 1, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return 1
+	}},
+	{Class: "fighter", Name: "Action Surge", Level: 2, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return byLevel(lvl, map[int]int{2: 1, 17: 2})
+	}},
+	{Class: "fighter", Name: "Indomitable", Level: 9, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return byLevel(lvl, map[int]int{9: 1, 13: 2, 17: 3})
+	}},
+	{Class: "monk", Name: "Ki", Level: 2, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return lvl
+	}},
+	{Class: "paladin", Name: "Divine Sense", Level: 1, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return 1 + max(0, Modifier(c.AbilityScore("CHA")))
+	}},
+	{Class: "paladin", Name: "Lay on Hands", Level: 1, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return 5 * lvl
+	}},
+	{Class: "paladin", Name: "Channel Divinity", Level: 3, Recharge: RechargeShortRest, Max: func(c *Character, lvl int) int {
+		return 1
+	}},
+	{Class: "sorcerer", Name: "Sorcery Points", Level: 2, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return lvl
+	}},
+	{Class: "wizard", Name: "Arcane Recovery", Level: 1, Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return 1
+	}},
+	{Feat: "Lucky", Name: "Luck Points", Recharge: RechargeLongRest, Max: func(c *Character, lvl int) int {
+		return 3
+	}},
+}
+
+// resourceRecharge returns the recharge type of a resource, which some class features improve
+func (c *Character) resourceRecharge(def resourceDefinition) RechargeType {
+	// Font of Inspiration: Bardic Inspiration recharges on a short rest from bard level 5
+	if def.Name == "Bardic Inspiration" && c.ClassLevel("bard") >= 5 {
+		return RechargeShortRest
+	}
+	return def.Recharge
+}
+
+// RefreshResources recalculates resource maximums from the character's classes and feats,
+// keeping track of uses already spent
+func (c *Character) RefreshResources() {
+	spent := make(map[string]int)
+	for _, r := range c.Resources {
+		spent[strings.ToLower(r.Name)] += r.Max - r.Current
+	}
+
+	var resources []Resource
+	for _, def := range resourceDefinitions {
+		classLevel := 0
+		switch {
+		case def.Feat != "":
+			if !c.HasFeat(def.Feat) {
+				continue
+			}
+		default:
+			classLevel = c.ClassLevel(def.Class)
+			if classLevel < def.Level {
+				continue
+			}
+		}
+
+		maxUses := def.Max(c, classLevel)
+		if maxUses <= 0 {
+			continue
+		}
+
+		// Two classes can grant a resource with the same name (Channel Divinity)
+		// D&D 5e multiclassing rule: gaining the feature again doesn't give additional uses, so the class
+		// granting the most uses counts
+		key := strings.ToLower(def.Name)
+		merged := false
+		for i := range resources {
+			if strings.ToLower(resources[i].Name) == key {
+				resources[i].Max = max(resources[i].Max, maxUses)
+				merged = true
+			}
+		}
+		if !merged {
+			resources = append(resources, Resource{Name: def.Name, Max: maxUses, Recharge: c.resourceRecharge(def)})
+		}
+	}
+
+	for i := range resources {
+		resources[i].Current = max(0, resources[i].Max-spent[strings.ToLower(resources[i].Name)])
+	}
+	c.Resources = resources
+}
+
+// findResource returns the index of a resource by name (case-insensitive), or -1
+func (c *Character) findResource(name string) int {
+	for i, r := range c.Resources {
+		if strings.EqualFold(r.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// UseResource spends uses of a limited resource, e.g. 1 Rage or 2 Ki points
+func (c *Character) UseResource(name string, amount int) (Resource, error) {
+	if amount < 1 {
+		return Resource{}, ErrInvalidResourceAmount
+	}
+	i := c.findResource(name)
+	if i < 0 {
+		return Resource{}, ErrUnknownResource
+	}
+	if c.Resources[i].Current < amount {
+		return c.Resources[i], ErrResourceDepleted
+	}
+
+	c.Resources[i].Current -= amount
+	return c.Resources[i], nil
+}
+
+// RestoreResource regains uses of a limited resource; an amount of 0 restores it fully
+func (c *Character) RestoreResource(name string, amount int) (Resource, error) {
+	if amount < 0 {
+		return Resource{}, ErrInvalidResourceAmount
+	}
+	i := c.findResource(name)
+	if i < 0 {
+		return Resource{}, ErrUnknownResource
+	}
+
+	r := &c.Resources[i]
+	if amount == 0 {
+		amount = r.Max
+	}
+	r.Current = min(r.Max, r.Current+amount)
+	return *r, nil
+}
+
+// rechargeResources restores every resource with one of the given recharge types and returns their names
+func (c *Character) rechargeResources(types ...RechargeType) []string {
+	var restored []string
+	for i := range c.Resources {
+		r := &c.Resources[i]
+		for _, t := range types {
+			if r.Recharge == t && r.Current < r.Max {
+				r.Current = r.Max
+				restored = append(restored, r.Name)
+			}
+		}
+	}
+	return restored
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "testing"
+
+func TestCharacter_ResourcesScaleWithLevel(t *testing.T) {
+	char := NewCharacter("Test", "human", "barbarian", 1, 15, 12, 14, 10, 10, 10, "outlander", nil)
+
+	if r, err := char.UseResource("rage", 1); err != nil || r.Current != 1 || r.Max != 2 {
+		t.Fatalf("Expected 1/2 Rage after one use, got %+v (err %v)", r, err)
+	}
+
+	if _, err := char.SetClassLevel("barbarian", 3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	i := char.findResource("Rage")
+	if i < 0 || char.Resources[i].Max != 3 || char.Resources[i].Current != 2 {
+		t.Errorf("Expected 2/3 Rage at level 3 with one use spent, got %+v", char.Resources)
+	}
+
+	if _, err := char.UseResource("rage", 3); err != ErrResourceDepleted {
+		t.Errorf("Expected ErrResourceDepleted, got %v", err)
+	}
+	if _, err := char.UseResource("ki", 1); err != ErrUnknownResource {
+		t.Errorf("Expected ErrUnknownResource, got %v", err)
+	}
+}
+
+func TestCharacter_RestsRechargeResources(t *testing.T) {
+	char := NewCharacter("Test", "human", "fighter", 9, 15, 12, 14, 10, 10, 10, "soldier", nil)
+
+	for _, name := range []string{"Second Wind", "Action Surge", "Indomitable"} {
+		if _, err := char.UseResource(name, 1); err != nil {
+			t.Fatalf("Unexpected error using %s: %v", name, err)
+		}
+	}
+
+	result, err := char.ShortRest(0, AverageHitDieRoll)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.ResourcesRestored) != 2 {
+		t.Errorf("Expected a short rest to recharge Second Wind and Action Surge, got %v", result.ResourcesRestored)
+	}
+	if r := char.Resources[char.findResource("Indomitable")]; r.Current != 0 {
+		t.Errorf("Expected Indomitable to stay spent after a short rest, got %d", r.Current)
+	}
+
+	result, err = char.LongRest()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.ResourcesRestored) != 1 || result.ResourcesRestored[0] != "Indomitable" {
+		t.Errorf("Expected a long rest to recharge Indomitable, got %v", result.ResourcesRestored)
+	}
+}
+
+func TestCharacter_ChannelDivinityMulticlass(t *testing.T) {
+	// A cleric 6 / paladin 3 has two uses of Channel Divinity from the cleric levels, not three
+	char := &Character{Class: "cleric", Classes: []ClassLevel{{Class: "cleric", Level: 6}, {Class: "paladin", Level: 3}}}
+	char.RefreshResources()
+
+	i := char.findResource("Channel Divinity")
+	if i < 0 || char.Resources[i].Max != 2 {
+		t.Errorf("Expected 2 uses of Channel Divinity, got %+v", char.Resources)
+	}
+}
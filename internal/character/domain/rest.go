@@ -0,0 +1,100 @@
+package domain
+
+// RestResult summarises what a character recovered during a rest
+type RestResult struct {
+	HitPointsRegained  int
+	HitDiceSpent       int
+	HitDiceRecovered   int
+	SpellSlotsRestored bool
+	ResourcesRestored  []string
+	ExhaustionRemoved  bool
+}
+
+// AverageHitDieRoll returns the fixed value that can be taken instead of rolling a hit die
+func AverageHitDieRoll(die int) int {
+	return die/2 + 1
+}
+
+// ShortRest spends hit dice, largest first, rolling each one with roll, and recovers Warlock Pact Magic slots
+// and short rest resources
+// D&D 5e rule: each hit die spent restores the roll plus the Con modifier (minimum 0); with the Durable feat it
+// restores at least twice the Con modifier (minimum 2)
+func (c *Character) ShortRest(hitDice int, roll func(die int) int) (RestResult, error) {
+	var result RestResult
+
+	if c.Dead {
+		return result, ErrCharacterDead
+	}
+	if hitDice > c.HitDice.Remaining() {
+		return result, ErrNoHitDice
+	}
+
+	conMod := Modifier(c.AbilityScore("CON"))
+	minimum := 0
+	if c.HasFeat("Durable") {
+		minimum = max(2, 2*conMod)
+	}
+	for i := 0; i < hitDice; i++ {
+		die, err := c.HitDice.Spend()
+		if err != nil {
+			return result, err
+		}
+		healed, err := c.Heal(max(minimum, roll(die)+conMod))
+		if err != nil {
+			return result, err
+		}
+		result.HitDiceSpent++
+		result.HitPointsRegained += healed
+	}
+
+	result.SpellSlotsRestored = c.recoverPactMagicSlots()
+	result.ResourcesRestored = c.rechargeResources(RechargeShortRest)
+	return result, nil
+}
+
+// LongRest restores hit points and spell slots, and recovers spent hit dice
+// D&D 5e rules: a character needs at least 1 hit point at the start of a long rest to benefit from it, and regains
+// spent hit dice up to half of its total number of hit dice (minimum of one), not half of the dice spent
+func (c *Character) LongRest() (RestResult, error) {
+	var result RestResult
+
+	if c.Dead {
+		return result, ErrCharacterDead
+	}
+	if c.CurrentHP < 1 {
+		return result, ErrRestRequiresHitPoints
+	}
+
+	// D&D 5e rule: finishing a long rest reduces exhaustion by one level
+	if c.Exhaustion > 0 {
+		c.Exhaustion--
+		result.ExhaustionRemoved = true
+	}
+
+	result.HitPointsRegained = c.MaxHitPoints() - c.CurrentHP
+	c.CurrentHP = c.MaxHitPoints()
+	c.TempHP = 0
+
+	// Regain spent hit dice up to half the total (minimum of one die)
+	result.HitDiceRecovered = c.HitDice.Recover(max(1, c.HitDice.Total()/2))
+
+	c.CurrentSpellSlots = copySlots(c.SpellSlots)
+	c.CurrentPactSlots = copySlots(c.PactSlots)
+	result.SpellSlotsRestored = len(c.SpellSlots) > 0 || len(c.PactSlots) > 0
+
+	// A long rest also recharges short rest resources; resources that return at dawn are treated as
+	// recharged, since a long rest ends with the next morning
+	result.ResourcesRestored = c.rechargeResources(RechargeShortRest, RechargeLongRest, RechargeDawn)
+
+	return result, nil
+}
+
+// recoverPactMagicSlots refills Warlock Pact Magic slots, which recharge on a short rest
+func (c *Character) recoverPactMagicSlots() bool {
+	if len(c.PactSlots) == 0 {
+		return false
+	}
+
+	c.CurrentPactSlots = copySlots(c.PactSlots)
+	return true
+}
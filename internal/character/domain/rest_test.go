@@ -0,0 +1,113 @@
+package domain
+
+import "testing"
+
+func TestCharacter_ShortRest(t *testing.T) {
+	// Level 4 fighter with Con 14: 10 + 3*6 + 4*2 = 36 max HP
+	char := &Character{Class: "fighter", Level: 4, Con: 14, CurrentHP: 10, HitDice: HitDicePool{NewHitDice(10, 4)}}
+
+	rolls := []int{6, 3}
+	result, err := char.ShortRest(2, func(die int) int {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.HitPointsRegained != 13 || char.CurrentHP != 23 {
+		t.Errorf("Expected 13 HP regained (roll + Con per die), got %d (HP %d)", result.HitPointsRegained, char.CurrentHP)
+	}
+	if char.HitDice.Remaining() != 2 {
+		t.Errorf("Expected 2 hit dice remaining, got %d", char.HitDice.Remaining())
+	}
+
+	if _, err := char.ShortRest(3, AverageHitDieRoll); err != ErrNoHitDice {
+		t.Errorf("Expected ErrNoHitDice when spending more dice than remaining, got %v", err)
+	}
+}
+
+func TestCharacter_ShortRest_Durable(t *testing.T) {
+	// Con 14: Durable makes each hit die restore at least 4 HP
+	char := &Character{Class: "fighter", Level: 4, Con: 14, CurrentHP: 10, HitDice: HitDicePool{NewHitDice(10, 4)}}
+	if result, _ := char.ShortRest(1, fixedRoll(1)); result.HitPointsRegained != 3 {
+		t.Errorf("Expected 3 HP without Durable, got %d", result.HitPointsRegained)
+	}
+
+	char.Feats = []CharacterFeat{{Name: "Durable", Ability: "CON"}}
+	if result, _ := char.ShortRest(1, fixedRoll(1)); result.HitPointsRegained != 4 {
+		t.Errorf("Expected Durable to restore at least 4 HP, got %d", result.HitPointsRegained)
+	}
+	if result, _ := char.ShortRest(1, fixedRoll(8)); result.HitPointsRegained != 10 {
+		t.Errorf("Expected a high roll to be unaffected by Durable, got %d", result.HitPointsRegained)
+	}
+}
+
+func TestCharacter_ShortRestRecoversPactMagic(t *testing.T) {
+	char := &Character{Class: "warlock", Level: 5, CurrentHP: 10, PactSlots: map[int]int{3: 2}, CurrentPactSlots: map[int]int{3: 0}}
+
+	if _, err := char.ShortRest(0, AverageHitDieRoll); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.CurrentPactSlots[3] != 2 {
+		t.Errorf("Expected 2 pact slots after short rest, got %d", char.CurrentPactSlots[3])
+	}
+}
+
+func TestCharacter_LongRest(t *testing.T) {
+	char := &Character{
+		Class: "wizard", Level: 5, Con: 10, CurrentHP: 3, TempHP: 4, HitDice: HitDicePool{{Die: 6, Total: 5}},
+		SpellSlots:        map[int]int{1: 4, 2: 3, 3: 2},
+		CurrentSpellSlots: map[int]int{1: 0, 2: 1, 3: 0},
+	}
+
+	result, err := char.LongRest()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if char.CurrentHP != char.MaxHitPoints() || char.TempHP != 0 {
+		t.Errorf("Expected full HP and no temp HP, got %d/%d (temp %d)", char.CurrentHP, char.MaxHitPoints(), char.TempHP)
+	}
+	if result.HitDiceRecovered != 2 || char.HitDice.Remaining() != 2 {
+		t.Errorf("Expected to recover half (2) hit dice, got %d", result.HitDiceRecovered)
+	}
+	for level, slots := range char.SpellSlots {
+		if char.CurrentSpellSlots[level] != slots {
+			t.Errorf("Expected level %d slots restored to %d, got %d", level, slots, char.CurrentSpellSlots[level])
+		}
+	}
+
+	char.CurrentHP = 0
+	if _, err := char.LongRest(); err != ErrRestRequiresHitPoints {
+		t.Errorf("Expected ErrRestRequiresHitPoints at 0 HP, got %v", err)
+	}
+}
+
+func TestCharacter_LongRest_HitDiceRecovery(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		remaining int
+		recovered int
+	}{
+		// Up to half the total, even when that is more than half of the spent dice
+		{"half of total", 10, 6, 4},
+		{"capped at half of total", 10, 0, 5},
+		{"minimum of one", 1, 0, 1},
+		{"nothing spent", 4, 4, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			char := &Character{Class: "fighter", Level: tt.total, Con: 10, CurrentHP: 1,
+				HitDice: HitDicePool{{Die: 10, Total: tt.total, Remaining: tt.remaining}}}
+			result, err := char.LongRest()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.HitDiceRecovered != tt.recovered {
+				t.Errorf("Expected to recover %d hit dice, got %d", tt.recovered, result.HitDiceRecovered)
+			}
+		})
+	}
+}
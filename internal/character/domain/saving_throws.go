@@ -0,0 +1,69 @@
+package domain
+
+import "strings"
+
+// Abilities lists the six ability abbreviations in character sheet order
+var Abilities = []string{"STR", "DEX", "CON", "INT", "WIS", "CHA"}
+
+// AbilityScore returns the character's score for an ability abbreviation such as "STR" (case-insensitive),
+// including magic items that set a score, such as Gauntlets of Ogre Power
+func (c *Character) AbilityScore(ability string) int {
+	score := c.BaseAbilityScore(ability)
+	for _, item := range c.activeMagicItems() {
+		score = max(score, item.AbilityScores[strings.ToUpper(ability)])
+	}
+	return score
+}
+
+// BaseAbilityScore returns the character's own score for an ability, without magic items
+func (c *Character) BaseAbilityScore(ability string) int {
+	switch strings.ToUpper(ability) {
+	case "STR":
+		return c.Str
+	case "DEX":
+		return c.Dex
+	case "CON":
+		return c.Con
+	case "INT":
+		return c.Int
+	case "WIS":
+		return c.Wis
+	case "CHA":
+		return c.Cha
+	default:
+		return 0
+	}
+}
+
+// IsProficientInSave checks whether the character's class or a feat grants proficiency in an ability's saving throw
+// D&D 5e rule: only the first class a character takes grants saving throw proficiencies
+func (c *Character) IsProficientInSave(ability string) bool {
+	if c.hasFeatSaveProficiency(ability) {
+		return true
+	}
+	for _, save := range NewClass(c.Class).GetSavingThrowProficiencies() {
+		if strings.EqualFold(save, ability) {
+			return true
+		}
+	}
+	return false
+}
+
+// SavingThrow calculates the saving throw modifier for an ability
+// D&D 5e rule: ability modifier, plus proficiency bonus if proficient in that save, plus magic item bonuses
+func (c *Character) SavingThrow(ability string) int {
+	save := Modifier(c.AbilityScore(ability)) + c.magicItemSavingThrowBonus()
+	if c.IsProficientInSave(ability) {
+		save += c.ProficiencyBonus
+	}
+	return save
+}
+
+// SavingThrows returns the saving throw modifier for every ability, keyed by abbreviation
+func (c *Character) SavingThrows() map[string]int {
+	saves := make(map[string]int, len(Abilities))
+	for _, ability := range Abilities {
+		saves[ability] = c.SavingThrow(ability)
+	}
+	return saves
+}
@@ -37,22 +37,7 @@ func (c *Character) SkillModifiers() map[string]int {
 	}
 	skillMods := make(map[string]int)
 	for skill, ability := range SkillAbility {
-		var score int
-		switch ability {
-		case "Str":
-			score = c.Str
-		case "Dex":
-			score = c.Dex
-		case "Con":
-			score = c.Con
-		case "Int":
-			score = c.Int
-		case "Wis":
-			score = c.Wis
-		case "Cha":
-			score = c.Cha
-		}
-		mod := Modifier(score)
+		mod := Modifier(c.AbilityScore(ability))
 		if prof[skill] {
 			mod += c.ProficiencyBonus
 		}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"fmt"
+	"strings"
+)
+
+// spellsKnownTable contains the spells of 1st level and higher known per class level, for the classes and subclasses
+// that learn spells
+// Source: PHB class tables; the Eldritch Knight and Arcane Trickster gain spellcasting at 3rd level
+var spellsKnownTable = map[string][20]int{
+	"bard":             {4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 15, 15, 16, 18, 19, 19, 20, 22, 22, 22},
+	"sorcerer":         {2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 13, 13, 14, 14, 15, 15, 15, 15},
+	"warlock":          {2, 3, 4, 5, 6, 7, 8, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15},
+	"ranger":           {0, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11},
+	"eldritch knight":  {0, 0, 3, 4, 4, 4, 5, 6, 6, 7, 8, 8, 9, 10, 10, 11, 11, 11, 12, 13},
+	"arcane trickster": {0, 0, 3, 4, 4, 4, 5, 6, 6, 7, 8, 8, 9, 10, 10, 11, 11, 11, 12, 13},
+}
+
+// cantripsKnownTable contains the cantrips known per class level
+// Source: PHB class tables; paladins and rangers don't learn cantrips
+var cantripsKnownTable = map[string][20]int{
+	"bard":             {2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+	"cleric":           {3, 3, 3, 4, 4, 4, 4, 4, 4, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+	"druid":            {2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+	"sorcerer":         {4, 4, 4, 5, 5, 5, 5, 5, 5, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+	"warlock":          {2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+	"wizard":           {3, 3, 3, 4, 4, 4, 4, 4, 4, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+	"artificer":        {2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4},
+	"eldritch knight":  {0, 0, 2, 2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+	"arcane trickster": {0, 0, 3, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+}
+
+// classTableEntry returns the entry for a class level from a per-level class table, or 0 for classes not in it
+// Subclasses that grant spellcasting, such as the Eldritch Knight, are looked up by subclass
+func classTableEntry(table map[string][20]int, cl ClassLevel) int {
+	levels, ok := table[casterKey(cl)]
+	if !ok || cl.Level < 1 {
+		return 0
+	}
+	return levels[min(cl.Level, 20)-1]
+}
+
+// MaxCantrips returns how many cantrips the character can know
+// A multiclass character adds up the cantrips of each spellcasting class
+func (c *Character) MaxCantrips() int {
+	cantrips := 0
+	for _, cl := range c.ClassLevels() {
+		cantrips += classTableEntry(cantripsKnownTable, cl)
+	}
+	return cantrips
+}
+
+// MaxSpellsKnown returns how many spells of 1st level and higher the character's classes that learn spells can know
+func (c *Character) MaxSpellsKnown() int {
+	known := 0
+	for _, cl := range c.ClassLevels() {
+		known += classTableEntry(spellsKnownTable, cl)
+	}
+	return known
+}
+
+// MaxPreparedSpells returns how many spells of 1st level and higher the character's classes that prepare spells can
+// have prepared
+// D&D 5e rule: spellcasting ability modifier + class level, or half the level rounded down for paladins and
+// artificers, with a minimum of one spell once the class can cast spells
+func (c *Character) MaxPreparedSpells() int {
+	prepared := 0
+	for _, cl := range c.ClassLevels() {
+		class := casterKey(cl)
+		if !spellcasters[class] || knownCasters[class] {
+			continue
+		}
+
+		level := cl.Level
+		if class == "paladin" || class == "artificer" {
+			level /= 2
+		}
+		if level == 0 {
+			// Paladins can't cast spells before 2nd level
+			continue
+		}
+		prepared += max(1, Modifier(c.AbilityScore(spellcastingAbility(class)))+level)
+	}
+	return prepared
+}
+
+// CheckSpellsKnown verifies the character has room to learn a spell; known holds the spells already known
+func (c *Character) CheckSpellsKnown(spell spelldomain.Spell, known []spelldomain.Spell) error {
+	return c.checkSpellLimit(spell, known, c.MaxSpellsKnown(), ErrTooManySpellsKnown)
+}
+
+// CheckSpellsPrepared verifies the character has room to prepare a spell; prepared holds the spells already prepared
+func (c *Character) CheckSpellsPrepared(spell spelldomain.Spell, prepared []spelldomain.Spell) error {
+	return c.checkSpellLimit(spell, prepared, c.MaxPreparedSpells(), ErrTooManySpellsPrepared)
+}
+
+// checkSpellLimit checks a spell against the limit of a spell list; cantrips count against MaxCantrips instead
+func (c *Character) checkSpellLimit(spell spelldomain.Spell, chosen []spelldomain.Spell, limit int, limitErr error) error {
+	cantrips, spells := 0, 0
+	for _, s := range chosen {
+		if s.IsCantrip() {
+			cantrips++
+		} else {
+			spells++
+		}
+	}
+
+	if spell.IsCantrip() {
+		if cantrips >= c.MaxCantrips() {
+			return fmt.Errorf("%w: %d of %d", ErrTooManyCantrips, cantrips, c.MaxCantrips())
+		}
+		return nil
+	}
+	if spells >= limit {
+		return fmt.Errorf("%w: %d of %d", limitErr, spells, limit)
+	}
+	return nil
+}
+
+// ForgetSpell removes a spell from the character's known spells and returns its name as it was known
+// D&D 5e rule: classes that learn spells can replace one known spell with another when they gain a level
+func (c *Character) ForgetSpell(name string) (string, error) {
+	forgotten, ok := removeSpell(&c.KnownSpells, name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSpellNotKnown, name)
+	}
+	return forgotten, nil
+}
+
+// UnprepareSpell removes a spell from the character's prepared spells and returns its name as it was prepared
+func (c *Character) UnprepareSpell(name string) (string, error) {
+	unprepared, ok := removeSpell(&c.PreparedSpells, name)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrSpellNotPrepared, name)
+	}
+	return unprepared, nil
+}
+
+// removeSpell removes a spell from a spell list (case-insensitive)
+func removeSpell(spells *[]string, name string) (string, bool) {
+	for i, spell := range *spells {
+		if strings.EqualFold(spell, strings.TrimSpace(name)) {
+			*spells = append((*spells)[:i], (*spells)[i+1:]...)
+			return spell, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"errors"
+	"testing"
+)
+
+func TestCharacter_SpellLimits(t *testing.T) {
+	tests := []struct {
+		name             string
+		char             *Character
+		expectedCantrips int
+		expectedKnown    int
+		expectedPrepared int
+	}{
+		{"Wizard prepares INT modifier + level", NewCharacter("Robin", "elf", "wizard", 3, 8, 14, 12, 16, 10, 10, "sage", nil), 3, 0, 6},
+		{"Sorcerer uses the spells known table", NewCharacter("Ash", "human", "sorcerer", 1, 8, 14, 12, 10, 10, 16, "sage", nil), 4, 2, 0},
+		{"Paladin prepares with half its level", NewCharacter("Aldric", "human", "paladin", 5, 16, 10, 14, 8, 10, 16, "soldier", nil), 0, 0, 5},
+		{"Paladin can't prepare spells at level 1", NewCharacter("Aldric", "human", "paladin", 1, 16, 10, 14, 8, 10, 15, "soldier", nil), 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.char.MaxCantrips(); got != tt.expectedCantrips {
+				t.Errorf("Expected %d cantrips, got %d", tt.expectedCantrips, got)
+			}
+			if got := tt.char.MaxSpellsKnown(); got != tt.expectedKnown {
+				t.Errorf("Expected %d spells known, got %d", tt.expectedKnown, got)
+			}
+			if got := tt.char.MaxPreparedSpells(); got != tt.expectedPrepared {
+				t.Errorf("Expected %d prepared spells, got %d", tt.expectedPrepared, got)
+			}
+		})
+	}
+}
+
+func TestCharacter_EldritchKnightSpellcasting(t *testing.T) {
+	char := NewCharacter("Kael", "human", "fighter", 3, 16, 12, 14, 14, 10, 8, "soldier", nil)
+	if char.IsSpellcaster() {
+		t.Fatal("Expected a fighter without a subclass not to cast spells")
+	}
+
+	if _, err := char.ChooseSubclass("fighter", "eldritch knight"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !char.IsSpellcaster() || !char.IsKnownCaster() || char.IsPreparedCaster() || char.SpellcastingAbility() != "INT" {
+		t.Errorf("Expected an Eldritch Knight to learn spells with INT, got ability %q", char.SpellcastingAbility())
+	}
+	if char.MaxCantrips() != 2 || char.MaxSpellsKnown() != 3 || char.MaxPreparedSpells() != 0 {
+		t.Errorf("Expected 2 cantrips and 3 spells known, got %d and %d", char.MaxCantrips(), char.MaxSpellsKnown())
+	}
+	if char.SpellSlots[1] != 2 || char.CurrentSpellSlots[1] != 2 {
+		t.Errorf("Expected two 1st level slots ready to use, got %v (current %v)", char.SpellSlots, char.CurrentSpellSlots)
+	}
+
+	shield := spelldomain.Spell{Name: "Shield", Level: 1, Classes: []string{"sorcerer", "wizard"}}
+	cureWounds := spelldomain.Spell{Name: "Cure Wounds", Level: 1, Classes: []string{"cleric"}}
+	if err := char.CheckSpellAccess(shield, false); err != nil {
+		t.Errorf("Expected an Eldritch Knight to learn wizard spells, got %v", err)
+	}
+	if err := char.CheckSpellAccess(cureWounds, false); !errors.Is(err, ErrSpellNotOnClassList) {
+		t.Errorf("Expected ErrSpellNotOnClassList for a cleric spell, got %v", err)
+	}
+}
+
+func TestCharacter_CheckSpellsKnown(t *testing.T) {
+	shield := spelldomain.Spell{Name: "Shield", Level: 1}
+	sleep := spelldomain.Spell{Name: "Sleep", Level: 1}
+	light := spelldomain.Spell{Name: "Light", Level: 0}
+
+	sorcerer := NewCharacter("Ash", "human", "sorcerer", 1, 8, 14, 12, 10, 10, 16, "sage", nil)
+	known := []spelldomain.Spell{shield, light}
+	if err := sorcerer.CheckSpellsKnown(sleep, known); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	known = append(known, sleep)
+	if err := sorcerer.CheckSpellsKnown(spelldomain.Spell{Name: "Magic Missile", Level: 1}, known); !errors.Is(err, ErrTooManySpellsKnown) {
+		t.Errorf("Expected ErrTooManySpellsKnown, got %v", err)
+	}
+
+	// Cantrips have their own limit
+	if err := sorcerer.CheckSpellsKnown(spelldomain.Spell{Name: "Fire Bolt", Level: 0}, known); err != nil {
+		t.Errorf("Unexpected error for a cantrip: %v", err)
+	}
+
+	sorcerer.KnownSpells = []string{"Shield", "Light", "Sleep"}
+	if _, err := sorcerer.ForgetSpell("shield"); err != nil {
+		t.Errorf("Unexpected error forgetting a spell: %v", err)
+	}
+	if _, err := sorcerer.ForgetSpell("shield"); !errors.Is(err, ErrSpellNotKnown) {
+		t.Errorf("Expected ErrSpellNotKnown, got %v", err)
+	}
+}
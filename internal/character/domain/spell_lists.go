@@ -0,0 +1,88 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"fmt"
+)
+
+// MaxSpellLevel returns the highest spell level the character has slots for, including Pact Magic slots,
+// or 0 if the character can only cast cantrips
+func (c *Character) MaxSpellLevel() int {
+	highest := 0
+	for _, slots := range []map[int]int{c.SpellSlots, c.PactSlots} {
+		for spellLevel, n := range slots {
+			if n > 0 && spellLevel > highest {
+				highest = spellLevel
+			}
+		}
+	}
+	return highest
+}
+
+// spellList returns the name of the class spell list a class uses, or an empty string if it can't cast spells
+// An Eldritch Knight or Arcane Trickster learns spells from the wizard spell list
+func spellList(cl ClassLevel) string {
+	key := casterKey(cl)
+	switch {
+	case subclassSpellLists[key] != "":
+		return subclassSpellLists[key]
+	case spellcasters[key]:
+		return cl.Class
+	}
+	return ""
+}
+
+// classSpellLevel returns the highest spell level a class can learn or prepare at its class level, which is the
+// highest slot level the class would have on its own, or 0 if it can only cast cantrips
+func classSpellLevel(cl ClassLevel) int {
+	var slots map[int]int
+	switch spellcastingProgression(casterKey(cl)) {
+	case "full":
+		slots = FullCasterSpellSlots(cl.Level)
+	case "half":
+		slots = HalfCasterSpellSlots(cl.Level)
+	case "third":
+		slots = ThirdCasterSpellSlots(cl.Level)
+	case "pact":
+		slots = PactMagicSpellSlots(cl.Level)
+	}
+
+	highest := 0
+	for spellLevel, n := range slots {
+		if n > 0 && spellLevel > highest {
+			highest = spellLevel
+		}
+	}
+	return highest
+}
+
+// IsOnSpellList checks if a spell is on the spell list of any of the character's spellcasting classes
+func (c *Character) IsOnSpellList(spell spelldomain.Spell) bool {
+	for _, cl := range c.ClassLevels() {
+		if list := spellList(cl); list != "" && spell.HasClass(list) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSpellAccess verifies the character can learn or prepare a spell
+// D&D 5e rules: spells come from a class spell list and must be of a level that class can cast at its own class
+// level, so a multiclass character's combined spell slots don't unlock higher-level spells; offList allows spells
+// granted by features such as Magical Secrets or domain spells, which are still limited by class level
+func (c *Character) CheckSpellAccess(spell spelldomain.Spell, offList bool) error {
+	if !offList && !c.IsOnSpellList(spell) {
+		return fmt.Errorf("%w: %s", ErrSpellNotOnClassList, spell.Name)
+	}
+
+	highest := 0
+	for _, cl := range c.ClassLevels() {
+		if list := spellList(cl); list != "" && (offList || spell.HasClass(list)) {
+			highest = max(highest, classSpellLevel(cl))
+		}
+	}
+	if spell.Level > highest {
+		return ErrSpellLevelTooHigh
+	}
+	return nil
+}
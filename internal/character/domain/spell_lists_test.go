@@ -0,0 +1,54 @@
+package domain
+
+import (
+	spelldomain "DnD-sheet/internal/spell/domain"
+	"errors"
+	"testing"
+)
+
+func TestCharacter_CheckSpellAccess(t *testing.T) {
+	cureWounds := spelldomain.Spell{Name: "Cure Wounds", Level: 1, Classes: []string{"Bard", "Cleric", "Druid", "Paladin", "Ranger"}}
+	fireball := spelldomain.Spell{Name: "Fireball", Level: 3, Classes: []string{"Sorcerer", "Wizard"}}
+	magicMissile := spelldomain.Spell{Name: "Magic Missile", Level: 1, Classes: []string{"Sorcerer", "Wizard"}}
+
+	wizard := NewCharacter("Robin", "elf", "wizard", 3, 8, 14, 12, 16, 10, 10, "sage", nil)
+	if err := wizard.CheckSpellAccess(magicMissile, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := wizard.CheckSpellAccess(cureWounds, false); !errors.Is(err, ErrSpellNotOnClassList) {
+		t.Errorf("Expected ErrSpellNotOnClassList for a wizard preparing Cure Wounds, got %v", err)
+	}
+	if err := wizard.CheckSpellAccess(fireball, false); !errors.Is(err, ErrSpellLevelTooHigh) {
+		t.Errorf("Expected ErrSpellLevelTooHigh for a level 3 wizard, got %v", err)
+	}
+
+	// Magical Secrets lets a bard take spells from any list, still limited by level
+	bard := NewCharacter("Lyra", "human", "bard", 10, 8, 14, 12, 10, 10, 16, "entertainer", nil)
+	if err := bard.CheckSpellAccess(fireball, true); err != nil {
+		t.Errorf("Unexpected error with the override: %v", err)
+	}
+
+	// A cleric 5 / wizard 1 has 3rd level slots from the multiclass table, but can only learn 1st level wizard spells
+	multiclass := NewCharacter("Ash", "human", "cleric", 5, 8, 14, 12, 14, 16, 10, "acolyte", nil)
+	if _, err := multiclass.SetClassLevel("wizard", 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if multiclass.MaxSpellLevel() != 3 {
+		t.Errorf("Expected the multiclass spell slots to reach level 3, got %d", multiclass.MaxSpellLevel())
+	}
+	if err := multiclass.CheckSpellAccess(magicMissile, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := multiclass.CheckSpellAccess(fireball, false); !errors.Is(err, ErrSpellLevelTooHigh) {
+		t.Errorf("Expected ErrSpellLevelTooHigh for a 1st level wizard learning Fireball, got %v", err)
+	}
+	spiritGuardians := spelldomain.Spell{Name: "Spirit Guardians", Level: 3, Classes: []string{"Cleric"}}
+	if err := multiclass.CheckSpellAccess(spiritGuardians, false); err != nil {
+		t.Errorf("Expected a 5th level cleric to prepare Spirit Guardians, got %v", err)
+	}
+
+	warlock := NewCharacter("Vex", "tiefling", "warlock", 5, 8, 14, 12, 10, 10, 16, "charlatan", nil)
+	if warlock.MaxSpellLevel() != 3 {
+		t.Errorf("Expected Pact Magic slots to allow level 3 spells, got %d", warlock.MaxSpellLevel())
+	}
+}
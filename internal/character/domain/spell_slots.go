@@ -42,6 +42,46 @@ func HalfCasterSpellSlots(level int) map[int]int {
 	return result
 }
 
+// ThirdCasterSpellSlots returns spell slots for the subclasses that cast spells as a third caster
+// (Eldritch Knight fighter and Arcane Trickster rogue)
+func ThirdCasterSpellSlots(level int) map[int]int {
+	// D&D 5e Eldritch Knight and Arcane Trickster spell slots
+	// Source: PHB Table
+	slots := map[int][]int{
+		1:  {0, 0, 0, 0},
+		2:  {0, 0, 0, 0},
+		3:  {2, 0, 0, 0},
+		4:  {3, 0, 0, 0},
+		5:  {3, 0, 0, 0},
+		6:  {3, 0, 0, 0},
+		7:  {4, 2, 0, 0},
+		8:  {4, 2, 0, 0},
+		9:  {4, 2, 0, 0},
+		10: {4, 3, 0, 0},
+		11: {4, 3, 0, 0},
+		12: {4, 3, 0, 0},
+		13: {4, 3, 2, 0},
+		14: {4, 3, 2, 0},
+		15: {4, 3, 2, 0},
+		16: {4, 3, 3, 0},
+		17: {4, 3, 3, 0},
+		18: {4, 3, 3, 0},
+		19: {4, 3, 3, 1},
+		20: {4, 3, 3, 1},
+	}
+	if level > 20 {
+		level = 20
+	}
+	arr := slots[level]
+	result := map[int]int{}
+	for i, v := range arr {
+		if v > 0 {
+			result[i+1] = v
+		}
+	}
+	return result
+}
+
 // FullCasterSpellSlots returns spell slots for full-caster classes (wizard, cleric, etc.)
 func FullCasterSpellSlots(level int) map[int]int {
 	// D&D 5e full-caster spell slots (wizard, cleric, etc.)
@@ -135,17 +175,3 @@ func PactMagicSpellSlots(level int) map[int]int {
 	result[0] = cantrips[level]
 	return result
 }
-
-// FullCasterCantrips returns the number of cantrips for full casters
-func FullCasterCantrips(level int) int {
-	switch {
-	case level >= 17:
-		return 5
-	case level >= 10:
-		return 5
-	case level >= 4:
-		return 4
-	default:
-		return 3
-	}
-}
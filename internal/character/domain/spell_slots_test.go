@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCharacter_CastSpellWithSlot(t *testing.T) {
+	wizard := NewCharacter("Robin", "elf", "wizard", 5, 8, 14, 12, 16, 10, 10, "sage", nil)
+
+	// Without a slot level the lowest available slot is used
+	used, err := wizard.CastSpellWithSlot(1, 0)
+	if err != nil || used != 1 {
+		t.Errorf("Expected a 1st level slot, got %d (%v)", used, err)
+	}
+
+	used, err = wizard.CastSpellWithSlot(1, 3)
+	if err != nil || used != 3 {
+		t.Errorf("Expected a 3rd level slot, got %d (%v)", used, err)
+	}
+	if wizard.CurrentSpellSlots[3] != 1 {
+		t.Errorf("Expected 1 3rd level slot left, got %d", wizard.CurrentSpellSlots[3])
+	}
+
+	if _, err := wizard.CastSpellWithSlot(2, 1); !errors.Is(err, ErrSlotTooLow) {
+		t.Errorf("Expected ErrSlotTooLow, got %v", err)
+	}
+
+	// Once the 1st level slots are spent, the spell moves up to a 2nd level slot
+	wizard.CurrentSpellSlots[1] = 0
+	if used, err := wizard.CastSpellWithSlot(1, 0); err != nil || used != 2 {
+		t.Errorf("Expected a 2nd level slot, got %d (%v)", used, err)
+	}
+
+	wizard.CurrentSpellSlots[3] = 0
+	if _, err := wizard.CastSpellWithSlot(1, 3); !errors.Is(err, ErrNoSpellSlot) {
+		t.Errorf("Expected ErrNoSpellSlot, got %v", err)
+	}
+}
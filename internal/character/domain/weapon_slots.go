@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Equipment slots that can be equipped and unequipped
+const (
+	SlotMainHand = "main hand"
+	SlotOffHand  = "off hand"
+	SlotArmor    = "armor"
+	SlotShield   = "shield"
+)
+
+// normalizeSlot lowercases a slot name and accepts "off-hand" for "off hand"; an empty slot means the main hand
+func normalizeSlot(slot string) string {
+	slot = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(slot, "-", " ")))
+	if slot == "" {
+		return SlotMainHand
+	}
+	return slot
+}
+
+// isLight checks if a weapon has the light property; improvised weapons don't
+func isLight(stats *WeaponStats) bool {
+	return stats != nil && stats.HasProperty("Light")
+}
+
+// isTwoHanded checks if a weapon needs both hands
+func isTwoHanded(stats *WeaponStats) bool {
+	return stats != nil && stats.HasProperty("Two-Handed")
+}
+
+// Equip wields a weapon in the main hand or off hand, keeping the inventory's equipped state in step
+// Weapons without catalog stats are used as improvised weapons; armor and shields are put on with
+// WearArmor and WearShield, which need their catalog stats
+// D&D 5e rules: a two-handed weapon can't be combined with an off-hand weapon or a shield, and
+// two-weapon fighting needs a light weapon in each hand
+func (c *Character) Equip(weapon string, stats *WeaponStats, slot string) error {
+	name := weapon
+	if stats != nil {
+		name = stats.DisplayName()
+	}
+
+	slot = normalizeSlot(slot)
+	switch slot {
+	case SlotMainHand:
+		if c.Weapon != "" {
+			return fmt.Errorf("%s %w", slot, ErrSlotOccupied)
+		}
+		if isTwoHanded(stats) && (c.OffHand != "" || c.Shield != "") {
+			return ErrHandsFull
+		}
+		if c.OffHand != "" && !(isLight(stats) && isLight(c.OffHandStats)) {
+			return ErrNotLightWeapon
+		}
+		c.Weapon, c.WeaponStats, c.WeaponSlot = name, stats, SlotMainHand
+
+	case SlotOffHand:
+		if c.OffHand != "" {
+			return fmt.Errorf("%s %w", slot, ErrSlotOccupied)
+		}
+		if c.Shield != "" {
+			return fmt.Errorf("%s %w by %s", slot, ErrSlotOccupied, c.Shield)
+		}
+		if isTwoHanded(stats) || isTwoHanded(c.WeaponStats) {
+			return ErrHandsFull
+		}
+		if c.Weapon != "" && !(isLight(stats) && isLight(c.WeaponStats)) {
+			return ErrNotLightWeapon
+		}
+		c.OffHand, c.OffHandStats = name, stats
+
+	default:
+		return ErrInvalidSlot
+	}
+
+	c.syncEquippedItems()
+	return nil
+}
+
+// equippedIn returns the name of what is equipped in a slot, or "" if the slot is empty
+func (c *Character) equippedIn(slot string) string {
+	switch normalizeSlot(slot) {
+	case SlotMainHand:
+		return c.Weapon
+	case SlotOffHand:
+		return c.OffHand
+	case SlotArmor:
+		return c.Armor
+	case SlotShield:
+		return c.Shield
+	}
+	return ""
+}
+
+// Unequip empties a slot and returns the name of what was in it
+func (c *Character) Unequip(slot string) (string, error) {
+	slot = normalizeSlot(slot)
+	name := c.equippedIn(slot)
+
+	switch slot {
+	case SlotMainHand, SlotOffHand, SlotArmor, SlotShield:
+		if name == "" {
+			return "", ErrSlotEmpty
+		}
+	default:
+		return "", ErrInvalidSlot
+	}
+
+	switch slot {
+	case SlotMainHand:
+		c.Weapon, c.WeaponStats, c.WeaponSlot = "", nil, ""
+	case SlotOffHand:
+		c.OffHand, c.OffHandStats = "", nil
+	case SlotArmor:
+		c.Armor, c.ArmorStats = "", nil
+	case SlotShield:
+		c.Shield, c.ShieldStats = "", nil
+	}
+
+	c.syncEquippedItems()
+	return name, nil
+}
+
+// SwapWeapons exchanges the weapons in the main hand and off hand
+func (c *Character) SwapWeapons() error {
+	if c.Weapon == "" && c.OffHand == "" {
+		return ErrSlotEmpty
+	}
+
+	mainHand, mainStats := c.Weapon, c.WeaponStats
+	offHand, offStats := c.OffHand, c.OffHandStats
+	c.Weapon, c.WeaponStats, c.WeaponSlot = "", nil, ""
+	c.OffHand, c.OffHandStats = "", nil
+
+	var err error
+	if offHand != "" {
+		err = c.Equip(offHand, offStats, SlotMainHand)
+	}
+	if err == nil && mainHand != "" {
+		err = c.Equip(mainHand, mainStats, SlotOffHand)
+	}
+	if err != nil {
+		// Put everything back where it was
+		c.Weapon, c.WeaponStats, c.WeaponSlot = mainHand, mainStats, SlotMainHand
+		c.OffHand, c.OffHandStats = offHand, offStats
+		if mainHand == "" {
+			c.WeaponSlot = ""
+		}
+	}
+	c.syncEquippedItems()
+	return err
+}
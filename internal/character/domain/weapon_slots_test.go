@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCharacter_WeaponSlots(t *testing.T) {
+	shortsword := &WeaponStats{Name: "Shortsword", Category: "Martial", Range: "Melee", Damage: "1d6", DamageType: "Piercing", Properties: []string{"Finesse", "Light"}}
+	dagger := &WeaponStats{Name: "Dagger", Category: "Simple", Range: "Melee", Damage: "1d4", DamageType: "Piercing", Properties: []string{"Finesse", "Light", "Thrown"}}
+	greatsword := &WeaponStats{Name: "Greatsword", Category: "Martial", Range: "Melee", Damage: "2d6", DamageType: "Slashing", Properties: []string{"Heavy", "Two-Handed"}}
+
+	char := &Character{Class: "fighter", Level: 1, ProficiencyBonus: 2, Dex: 16}
+	if err := char.Equip("shortsword", shortsword, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := char.Equip("dagger", dagger, "off hand"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := char.WearShield(ArmorStats{Name: "Shield", Category: "Shield", BaseAC: 2}); !errors.Is(err, ErrSlotOccupied) {
+		t.Errorf("Expected the off-hand dagger to block the shield, got %v", err)
+	}
+
+	attacks := char.WeaponAttacks()
+	if len(attacks) != 2 || attacks[1].Damage != "1d4" {
+		t.Fatalf("Expected an off-hand attack without the Dex modifier on damage, got %v", attacks)
+	}
+	char.FightingStyle = "Two-Weapon Fighting"
+	if damage := char.WeaponAttacks()[1].Damage; damage != "1d4+3" {
+		t.Errorf("Expected Two-Weapon Fighting to add the Dex modifier, got %s", damage)
+	}
+
+	if _, err := char.Unequip("main hand"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := char.Equip("greatsword", greatsword, "main hand"); !errors.Is(err, ErrHandsFull) {
+		t.Errorf("Expected ErrHandsFull for a greatsword with an off-hand dagger, got %v", err)
+	}
+	if err := char.SwapWeapons(); err != nil || char.Weapon != "Dagger" || char.OffHand != "" {
+		t.Errorf("Expected the dagger to move to the main hand, got %q / %q (err %v)", char.Weapon, char.OffHand, err)
+	}
+}
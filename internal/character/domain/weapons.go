@@ -0,0 +1,216 @@
+package domain
+
+import (
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
+	"fmt"
+	"strings"
+)
+
+// WeaponStats describes a wielded weapon, copied from the equipment catalog when it is equipped
+type WeaponStats struct {
+	Name            string   `json:"name"`
+	Category        string   `json:"category"` // Simple or Martial
+	Range           string   `json:"range"`    // Melee or Ranged
+	Damage          string   `json:"damage"`   // damage dice, e.g. "1d8"
+	DamageType      string   `json:"damage_type"`
+	NormalRange     int      `json:"normal_range,omitempty"` // in feet, for ranged and thrown weapons
+	LongRange       int      `json:"long_range,omitempty"`
+	Properties      []string `json:"properties,omitempty"`
+	VersatileDamage string   `json:"versatile_damage,omitempty"` // damage dice when wielded with two hands
+	MagicBonus      int      `json:"magic_bonus,omitempty"`      // e.g. 1 for a +1 longsword
+	Ammunition      string   `json:"ammunition,omitempty"`       // inventory item used up by each attack, e.g. "Arrow"
+}
+
+// improvisedWeapon is used for wielded objects that aren't in the weapon catalog
+// D&D 5e rule: an improvised weapon deals 1d4 damage and doesn't add proficiency
+var improvisedWeapon = WeaponStats{Range: "Melee", Damage: "1d4", DamageType: "Bludgeoning"}
+
+// HasProperty checks if the weapon has a property such as "Finesse" (case-insensitive)
+func (w WeaponStats) HasProperty(property string) bool {
+	for _, p := range w.Properties {
+		if strings.EqualFold(p, property) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRanged checks if the weapon is a ranged weapon; thrown melee weapons such as daggers are not
+func (w WeaponStats) IsRanged() bool {
+	return w.Range == "Ranged"
+}
+
+// DisplayName returns the name including any magic bonus, e.g. "+1 Longsword"
+func (w WeaponStats) DisplayName() string {
+	if w.MagicBonus > 0 {
+		return fmt.Sprintf("+%d %s", w.MagicBonus, w.Name)
+	}
+	return w.Name
+}
+
+// FindWeapon looks up a weapon in the equipment catalog; a "+1" prefix is read as a magic bonus
+// An unknown name gives ErrUnknownWeapon with suggestions for similar weapon names
+func FindWeapon(catalog equipmentdomain.EquipmentRepository, name string) (WeaponStats, error) {
+	baseName, bonus := ParseMagicBonus(name)
+
+	item, err := catalog.FindByName(baseName)
+	if err != nil {
+		err = fmt.Errorf("%w: %q is not in the equipment catalog", ErrUnknownWeapon, name)
+		if suggestions := suggestWeapons(catalog, baseName); len(suggestions) > 0 {
+			err = fmt.Errorf("%w, did you mean %s?", err, strings.Join(suggestions, ", "))
+		}
+		return WeaponStats{}, err
+	}
+	if !item.IsWeapon() {
+		return WeaponStats{}, fmt.Errorf("%w: %s is not a weapon", ErrUnknownWeapon, item.Name)
+	}
+
+	return WeaponStats{
+		Name:            item.Name,
+		Category:        item.WeaponCategory,
+		Range:           item.WeaponRange,
+		Damage:          item.Damage,
+		DamageType:      item.DamageType,
+		NormalRange:     item.Range.Normal,
+		LongRange:       item.Range.Long,
+		Properties:      item.Properties,
+		VersatileDamage: item.VersatileDamage,
+		MagicBonus:      bonus,
+		Ammunition:      item.Ammunition,
+	}, nil
+}
+
+// suggestWeapons returns the names of catalog weapons that look like a misspelling of name
+func suggestWeapons(catalog equipmentdomain.EquipmentRepository, name string) []string {
+	items, err := catalog.LoadAll()
+	if err != nil {
+		return nil
+	}
+	var weapons []equipmentdomain.Equipment
+	for _, item := range items {
+		if item.IsWeapon() {
+			weapons = append(weapons, item)
+		}
+	}
+	return equipmentdomain.Suggest(weapons, name, 3)
+}
+
+// Attack describes a weapon attack as shown on the character sheet
+type Attack struct {
+	Name            string
+	AttackBonus     int
+	Damage          string // damage dice plus modifiers, e.g. "1d8+3"
+	VersatileDamage string // damage when a versatile weapon is wielded with two hands
+	DamageType      string
+	Range           string
+	Properties      []string
+	Proficient      bool
+	OffHand         bool // bonus action attack with the off-hand weapon
+	IsMelee         bool
+	IsRanged        bool
+	IsFinesse       bool
+	IsTwoHanded     bool
+}
+
+// String formats the attack, e.g. "Longsword: +5 to hit, 1d8+3 slashing (1d10+3 two-handed), 5 ft"
+func (a Attack) String() string {
+	damage := "no damage" // e.g. a net
+	if a.Damage != "" {
+		damage = fmt.Sprintf("%s %s", a.Damage, strings.ToLower(a.DamageType))
+	}
+	if a.VersatileDamage != "" {
+		damage += fmt.Sprintf(" (%s two-handed)", a.VersatileDamage)
+	}
+	return fmt.Sprintf("%s: %+d to hit, %s, %s", a.Name, a.AttackBonus, damage, a.Range)
+}
+
+// damageRoll adds a modifier to damage dice, e.g. "1d8" and 3 give "1d8+3"
+func damageRoll(dice string, modifier int) string {
+	if dice == "" || modifier == 0 {
+		return dice
+	}
+	return fmt.Sprintf("%s%+d", dice, modifier)
+}
+
+// WeaponAttacks returns the attacks the character can make with the wielded weapons
+func (c *Character) WeaponAttacks() []Attack {
+	var attacks []Attack
+	if c.Weapon != "" {
+		attacks = append(attacks, c.weaponAttack(c.Weapon, c.WeaponStats, false))
+	}
+	if c.OffHand != "" {
+		attacks = append(attacks, c.weaponAttack(c.OffHand, c.OffHandStats, true))
+	}
+	return attacks
+}
+
+// weaponAttack calculates the attack bonus and damage of a weapon (D&D 5e rules)
+func (c *Character) weaponAttack(name string, stats *WeaponStats, offHand bool) Attack {
+	weapon := improvisedWeapon
+	if stats != nil {
+		weapon = *stats
+	}
+	if offHand {
+		name += " (off hand)"
+	}
+
+	attack := Attack{
+		Name:        name,
+		OffHand:     offHand,
+		DamageType:  weapon.DamageType,
+		Properties:  weapon.Properties,
+		IsRanged:    weapon.IsRanged(),
+		IsMelee:     !weapon.IsRanged(),
+		IsFinesse:   weapon.HasProperty("Finesse"),
+		IsTwoHanded: weapon.HasProperty("Two-Handed"),
+		Proficient:  c.IsProficientWithWeapon(weapon), // never for improvised weapons
+	}
+
+	// Melee weapons use Str and ranged weapons Dex; finesse weapons use the better of the two
+	strMod, dexMod := Modifier(c.AbilityScore("STR")), Modifier(c.AbilityScore("DEX"))
+	abilityMod := strMod
+	if attack.IsRanged {
+		abilityMod = dexMod
+	}
+	if attack.IsFinesse {
+		abilityMod = max(strMod, dexMod)
+	}
+
+	// Magic weapons such as a Defender only add their bonus for a character attuned to them
+	magicAttack, magicDamage := c.magicWeaponBonus(weapon)
+
+	attack.AttackBonus = abilityMod + weapon.MagicBonus + magicAttack + c.FightingStyleAttackBonus(attack.IsRanged)
+	if attack.Proficient {
+		attack.AttackBonus += c.ProficiencyBonus
+	}
+
+	// Two-weapon fighting: the off-hand attack doesn't add a positive ability modifier to damage
+	// unless the character has the Two-Weapon Fighting style
+	damageMod := abilityMod + weapon.MagicBonus + magicDamage
+	if offHand && abilityMod > 0 && !c.fightingStyle().OffHandDamage {
+		damageMod -= abilityMod
+	}
+
+	// Dueling only applies with no other weapon in hand
+	dualWielding := c.Weapon != "" && c.OffHand != ""
+	attack.Damage = damageRoll(weapon.Damage, damageMod+c.FightingStyleDamageBonus(attack.IsMelee, attack.IsTwoHanded || dualWielding))
+
+	// A versatile weapon can only be held in two hands while the other hand is free
+	if weapon.VersatileDamage != "" && !dualWielding && c.Shield == "" {
+		attack.VersatileDamage = damageRoll(weapon.VersatileDamage, damageMod)
+	}
+
+	// Reach adds 5 feet to melee attacks; thrown weapons can also be used at range
+	switch {
+	case attack.IsRanged:
+		attack.Range = fmt.Sprintf("%d/%d ft", weapon.NormalRange, weapon.LongRange)
+	case weapon.HasProperty("Thrown"):
+		attack.Range = fmt.Sprintf("5 ft or thrown %d/%d ft", weapon.NormalRange, weapon.LongRange)
+	case weapon.HasProperty("Reach"):
+		attack.Range = "10 ft"
+	default:
+		attack.Range = "5 ft"
+	}
+
+	return attack
+}
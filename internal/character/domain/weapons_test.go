@@ -0,0 +1,44 @@
+package domain
+
+import "testing"
+
+func TestCharacter_WeaponAttacks(t *testing.T) {
+	rapier := &WeaponStats{Name: "Rapier", Category: "Martial", Range: "Melee", Damage: "1d8", DamageType: "Piercing", Properties: []string{"Finesse"}}
+	longbow := &WeaponStats{Name: "Longbow", Category: "Martial", Range: "Ranged", Damage: "1d8", DamageType: "Piercing", NormalRange: 150, LongRange: 600, Properties: []string{"Ammunition", "Heavy", "Two-Handed"}}
+
+	tests := []struct {
+		name     string
+		char     *Character
+		expected string
+	}{
+		{
+			name:     "Finesse uses Dex",
+			char:     &Character{Class: "rogue", Level: 1, ProficiencyBonus: 2, Str: 8, Dex: 16, Weapon: "Rapier", WeaponStats: rapier},
+			expected: "Rapier: +5 to hit, 1d8+3 piercing, 5 ft",
+		},
+		{
+			name:     "Not proficient",
+			char:     &Character{Class: "wizard", Level: 1, ProficiencyBonus: 2, Dex: 14, Weapon: "Longbow", WeaponStats: longbow},
+			expected: "Longbow: +2 to hit, 1d8+2 piercing, 150/600 ft",
+		},
+		{
+			name:     "Magic weapon",
+			char:     &Character{Class: "fighter", Level: 1, ProficiencyBonus: 2, Str: 16, Weapon: "+1 Longsword", WeaponStats: &WeaponStats{Name: "Longsword", Category: "Martial", Range: "Melee", Damage: "1d8", DamageType: "Slashing", Properties: []string{"Versatile"}, VersatileDamage: "1d10", MagicBonus: 1}},
+			expected: "+1 Longsword: +6 to hit, 1d8+4 slashing (1d10+4 two-handed), 5 ft",
+		},
+		{
+			name:     "Improvised weapon",
+			char:     &Character{Class: "fighter", Level: 1, ProficiencyBonus: 2, Str: 14, Weapon: "chair"},
+			expected: "chair: +2 to hit, 1d4+2 bludgeoning, 5 ft",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attacks := tt.char.WeaponAttacks()
+			if len(attacks) != 1 || attacks[0].String() != tt.expected {
+				t.Errorf("Expected %q, got %v", tt.expected, attacks)
+			}
+		})
+	}
+}
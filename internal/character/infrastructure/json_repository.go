@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"DnD-sheet/internal/character/domain"
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
 	"encoding/json"
 	"os"
 	"path/filepath"
@@ -9,7 +10,8 @@ import (
 
 // JSONCharacterRepository implements character persistence using JSON files
 type JSONCharacterRepository struct {
-	dataDir string
+	dataDir   string
+	equipment equipmentdomain.EquipmentRepository // optional, used to migrate equipped weapons and armor
 }
 
 // NewJSONCharacterRepository creates a new JSON repository
@@ -17,6 +19,12 @@ func NewJSONCharacterRepository(dataDir string) *JSONCharacterRepository {
 	return &JSONCharacterRepository{dataDir: dataDir}
 }
 
+// NewJSONCharacterRepositoryWithEquipment creates a JSON repository that looks up the weapon and
+// armor stats of characters saved before they came from the equipment catalog
+func NewJSONCharacterRepositoryWithEquipment(dataDir string, equipment equipmentdomain.EquipmentRepository) *JSONCharacterRepository {
+	return &JSONCharacterRepository{dataDir: dataDir, equipment: equipment}
+}
+
 // Save persists a character to a JSON file
 func (r *JSONCharacterRepository) Save(character *domain.Character) error {
 	if err := os.MkdirAll(r.dataDir, 0755); err != nil {
@@ -34,18 +42,105 @@ func (r *JSONCharacterRepository) Save(character *domain.Character) error {
 // Load retrieves a character by name from a JSON file
 func (r *JSONCharacterRepository) Load(name string) (*domain.Character, error) {
 	path := filepath.Join(r.dataDir, name+".json")
-	f, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 	var c domain.Character
-	if err := json.NewDecoder(f).Decode(&c); err != nil {
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if err := migrateLegacyFields(data, &c); err != nil {
 		return nil, err
 	}
+	r.migrateEquipmentStats(&c)
 	return &c, nil
 }
 
+// legacyFields detects fields that are missing from character files written by older versions
+type legacyFields struct {
+	CurrentHP   *int                `json:"current_hp"`
+	HitDicePool *domain.HitDicePool `json:"hit_dice_pool"`
+	PactSlots   *map[int]int        `json:"pact_slots"`
+	Resources   *[]domain.Resource  `json:"resources"`
+}
+
+// migrateLegacyFields fills in defaults for fields that older character files don't have
+func migrateLegacyFields(data []byte, c *domain.Character) error {
+	var legacy legacyFields
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+
+	// Characters saved before hit point tracking start at full health
+	if legacy.CurrentHP == nil {
+		c.CurrentHP = c.MaxHitPoints()
+	}
+
+	// Characters saved before hit dice tracking get a full pool
+	if legacy.HitDicePool == nil {
+		c.HitDice = domain.NewHitDicePool(c.ClassLevels())
+	}
+
+	// Warlocks saved before Pact Magic was tracked separately kept their pact slots with the regular spell slots
+	if legacy.PactSlots == nil && c.ClassLevel("warlock") > 0 {
+		c.PactSlots = c.GetPactSlots()
+		c.CurrentPactSlots = make(map[int]int)
+		for slotLevel := range c.PactSlots {
+			c.CurrentPactSlots[slotLevel] = c.CurrentSpellSlots[slotLevel]
+			delete(c.CurrentSpellSlots, slotLevel)
+		}
+		c.SpellSlots = c.GetSpellSlots()
+	}
+
+	// Characters saved before dual wielding kept a single weapon with a free-text slot
+	if c.WeaponSlot == domain.SlotOffHand && c.OffHand == "" {
+		c.OffHand, c.OffHandStats = c.Weapon, c.WeaponStats
+		c.Weapon, c.WeaponStats, c.WeaponSlot = "", nil, ""
+	}
+
+	// Characters saved before class resources were tracked start with them fully charged
+	if legacy.Resources == nil {
+		c.RefreshResources()
+	}
+
+	return nil
+}
+
+// migrateEquipmentStats looks up the catalog stats of weapons and armor equipped by characters saved
+// before they came from the equipment catalog; unknown armor is left without stats, as it gave no AC
+// before either, and unknown weapons are used as improvised weapons
+func (r *JSONCharacterRepository) migrateEquipmentStats(c *domain.Character) {
+	if r.equipment == nil {
+		return
+	}
+	if c.Weapon != "" && needsWeaponStats(c.WeaponStats) {
+		if stats, err := domain.FindWeapon(r.equipment, c.Weapon); err == nil {
+			c.WeaponStats = &stats
+		}
+	}
+	if c.OffHand != "" && needsWeaponStats(c.OffHandStats) {
+		if stats, err := domain.FindWeapon(r.equipment, c.OffHand); err == nil {
+			c.OffHandStats = &stats
+		}
+	}
+	if c.Armor != "" && c.ArmorStats == nil {
+		if stats, err := domain.FindArmor(r.equipment, c.Armor); err == nil && !stats.IsShield() {
+			c.ArmorStats = &stats
+		}
+	}
+	if c.Shield != "" && c.ShieldStats == nil {
+		if stats, err := domain.FindArmor(r.equipment, c.Shield); err == nil && stats.IsShield() {
+			c.ShieldStats = &stats
+		}
+	}
+}
+
+// needsWeaponStats checks if saved weapon stats are missing, or predate ammunition tracking
+func needsWeaponStats(stats *domain.WeaponStats) bool {
+	return stats == nil || (stats.HasProperty("Ammunition") && stats.Ammunition == "")
+}
+
 // Delete removes a character's JSON file
 func (r *JSONCharacterRepository) Delete(name string) error {
 	path := filepath.Join(r.dataDir, name+".json")
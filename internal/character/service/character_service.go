@@ -2,7 +2,9 @@ package service
 
 import (
 	"DnD-sheet/internal/character/domain"
-	"DnD-sheet/internal/spell"
+	"DnD-sheet/internal/dice"
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
+	spelldomain "DnD-sheet/internal/spell/domain"
 	"errors"
 	"fmt"
 	"sort"
@@ -11,12 +13,16 @@ import (
 
 // CharacterService handles character business logic
 type CharacterService struct {
-	repo domain.CharacterRepository
+	repo      domain.CharacterRepository
+	roller    dice.Roller
+	equipment equipmentdomain.EquipmentRepository // optional catalog used to validate inventory items
+	spells    spelldomain.SpellRepository         // catalog spells are looked up in
 }
 
-// NewCharacterService creates a new character service
-func NewCharacterService(repo domain.CharacterRepository) *CharacterService {
-	return &CharacterService{repo: repo}
+// NewCharacterService creates a new character service that looks up spells in a spell catalog
+// The equipment catalog is optional; without one, inventory items aren't checked against it
+func NewCharacterService(repo domain.CharacterRepository, equipment equipmentdomain.EquipmentRepository, spells spelldomain.SpellRepository) *CharacterService {
+	return &CharacterService{repo: repo, roller: dice.NewRandomRoller(), equipment: equipment, spells: spells}
 }
 
 // GetRepository returns the character repository (for web server access)
@@ -24,6 +30,11 @@ func (s *CharacterService) GetRepository() domain.CharacterRepository {
 	return s.repo
 }
 
+// GetSpellRepository returns the spell catalog
+func (s *CharacterService) GetSpellRepository() spelldomain.SpellRepository {
+	return s.spells
+}
+
 // validSkills maps skill names for validation
 var validSkills = map[string]bool{
 	"acrobatics": true, "animal handling": true, "arcana": true, "athletics": true,
@@ -76,7 +87,7 @@ func (s *CharacterService) CreateCharacter(req CreateCharacterRequest) (*domain.
 	req.Cha += bonuses["cha"]
 
 	// Automatically assign skill proficiencies based on D&D rules
-	skills := s.generateSkillProficiencies(req.Background, req.Class)
+	skills := s.generateSkillProficiencies(req.Background, req.Class, req.Race)
 
 	// Validate skills
 	if err := s.validateSkills(skills); err != nil {
@@ -90,6 +101,14 @@ func (s *CharacterService) CreateCharacter(req CreateCharacterRequest) (*domain.
 		req.Background, skills,
 	)
 
+	// Starting equipment and coins come from the class and background, with weights from the equipment catalog
+	c.AddStartingEquipment()
+	for i := range c.Inventory {
+		if err := s.applyCatalog(&c.Inventory[i]); err != nil {
+			return nil, err
+		}
+	}
+
 	// Save character
 	if err := s.repo.Save(c); err != nil {
 		return nil, err
@@ -113,68 +132,594 @@ func (s *CharacterService) DeleteCharacter(name string) error {
 	return s.repo.Delete(name)
 }
 
-// UpdateLevel updates a character's level and recalculates dependent stats
-func (s *CharacterService) UpdateLevel(name string, newLevel int) error {
+// UpdateLevel updates a character's total level and recalculates dependent stats
+// Levels gained or lost go to the character's primary class
+func (s *CharacterService) UpdateLevel(name string, newLevel int) (domain.LevelUpResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.LevelUpResult{}, err
+	}
+
+	return s.setClassLevel(c, c.Class, c.ClassLevel(c.Class)+newLevel-c.Level)
+}
+
+// UpdateClassLevel sets a character's level in one class, multiclassing into it if needed
+func (s *CharacterService) UpdateClassLevel(name, class string, classLevel int) (domain.LevelUpResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.LevelUpResult{}, err
+	}
+
+	return s.setClassLevel(c, class, classLevel)
+}
+
+// setClassLevel applies a class level change and saves the character
+func (s *CharacterService) setClassLevel(c *domain.Character, class string, classLevel int) (domain.LevelUpResult, error) {
+	result, err := c.SetClassLevel(class, classLevel)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// ChooseSubclass picks the subclass for one of a character's classes, defaulting to the primary class
+func (s *CharacterService) ChooseSubclass(name, class, subclass string) ([]domain.ClassFeature, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if class == "" {
+		class = c.Class
+	}
+	features, err := c.ChooseSubclass(class, subclass)
+	if err != nil {
+		return nil, err
+	}
+
+	return features, s.repo.Save(c)
+}
+
+// ChooseFightingStyle sets a character's Fighting Style
+func (s *CharacterService) ChooseFightingStyle(name, style string) error {
 	c, err := s.repo.Load(name)
 	if err != nil {
 		return err
 	}
 
-	oldLevel := c.Level
-	c.Level = newLevel
-	c.ProficiencyBonus = domain.ProficiencyBonus(newLevel)
-	c.ApplySRDAbilityScoreImprovements(oldLevel, newLevel)
-	c.SpellSlots = c.GetSpellSlots()
+	if err := c.ChooseFightingStyle(style); err != nil {
+		return err
+	}
 
 	return s.repo.Save(c)
 }
 
-// EquipCharacter equips a character with weapons, armor, and shields
-func (s *CharacterService) EquipCharacter(name, weapon, armor, shield, weaponSlot string) error {
+// ImproveAbilityScores spends a pending Ability Score Improvement on +2 to one ability or +1 to two
+func (s *CharacterService) ImproveAbilityScores(name string, abilities []string) error {
 	c, err := s.repo.Load(name)
 	if err != nil {
 		return err
 	}
 
-	if weapon != "" {
-		// Check if character already has a weapon equipped
-		if c.Weapon != "" {
-			// Determine the target slot
-			targetSlot := weaponSlot
-			if targetSlot == "" {
-				targetSlot = "main hand" // Default slot
-			}
+	if err := c.ImproveAbilityScores(abilities); err != nil {
+		return err
+	}
 
-			// Check if the current weapon is in the same slot we're trying to equip to
-			currentSlot := c.WeaponSlot
-			if currentSlot == "" {
-				currentSlot = "main hand" // Default slot for existing weapon
-			}
+	return s.repo.Save(c)
+}
 
-			if currentSlot == targetSlot {
-				return fmt.Errorf("%s already occupied", targetSlot)
-			}
+// TakeFeat spends a pending Ability Score Improvement on a feat
+func (s *CharacterService) TakeFeat(name, feat, ability string) error {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.TakeFeat(feat, ability); err != nil {
+		return err
+	}
+
+	return s.repo.Save(c)
+}
+
+// DamageCharacter applies damage to a character, spending temporary hit points first
+func (s *CharacterService) DamageCharacter(name string, amount int) (domain.DamageResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.DamageResult{}, err
+	}
+
+	result, err := c.TakeDamage(amount)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// HealCharacter restores hit points to a character and returns the amount healed
+func (s *CharacterService) HealCharacter(name string, amount int) (int, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return 0, err
+	}
+
+	healed, err := c.Heal(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return healed, s.repo.Save(c)
+}
+
+// GrantTemporaryHitPoints gives a character temporary hit points
+// Returns false if the character already had at least that many temporary hit points
+func (s *CharacterService) GrantTemporaryHitPoints(name string, amount int) (bool, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return false, err
+	}
+
+	replaced, err := c.GrantTempHP(amount)
+	if err != nil || !replaced {
+		return false, err
+	}
+
+	return true, s.repo.Save(c)
+}
+
+// AdjustMaxHitPoints raises or lowers a character's hit point maximum
+func (s *CharacterService) AdjustMaxHitPoints(name string, delta int) error {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return err
+	}
+
+	c.AdjustMaxHitPoints(delta)
+	return s.repo.Save(c)
+}
+
+// RollDeathSave makes a death saving throw for a dying character
+// A roll of 0 means the d20 is rolled automatically
+func (s *CharacterService) RollDeathSave(name string, roll int) (domain.DeathSaveResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.DeathSaveResult{}, err
+	}
+
+	if roll == 0 {
+		roll = s.roller.Roll(20)
+	}
+
+	result, err := c.RollDeathSave(roll)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// StabilizeCharacter makes a dying character stable
+func (s *CharacterService) StabilizeCharacter(name string) error {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Stabilize(); err != nil {
+		return err
+	}
+
+	return s.repo.Save(c)
+}
+
+// ShortRest spends hit dice to recover hit points and recharges short rest features
+// Hit dice are rolled unless useAverage is set, in which case the fixed average is taken
+func (s *CharacterService) ShortRest(name string, hitDice int, useAverage bool) (domain.RestResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.RestResult{}, err
+	}
+
+	if hitDice < 0 {
+		return domain.RestResult{}, errors.New("number of hit dice must not be negative")
+	}
+
+	roll := s.roller.Roll
+	if useAverage {
+		roll = domain.AverageHitDieRoll
+	}
+
+	result, err := c.ShortRest(hitDice, roll)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// LongRest restores a character's hit points, spell slots and part of their hit dice
+func (s *CharacterService) LongRest(name string) (domain.RestResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.RestResult{}, err
+	}
+
+	result, err := c.LongRest()
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// UseResource spends uses of a limited class resource such as Rage or Ki
+func (s *CharacterService) UseResource(name, resource string, amount int) (domain.Resource, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.Resource{}, err
+	}
+
+	r, err := c.UseResource(resource, amount)
+	if err != nil {
+		return r, err
+	}
+
+	return r, s.repo.Save(c)
+}
+
+// RestoreResource regains uses of a limited class resource; an amount of 0 restores it fully
+func (s *CharacterService) RestoreResource(name, resource string, amount int) (domain.Resource, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.Resource{}, err
+	}
+
+	r, err := c.RestoreResource(resource, amount)
+	if err != nil {
+		return r, err
+	}
+
+	return r, s.repo.Save(c)
+}
+
+// AddCondition applies a condition to a character; exhaustion is raised by levels instead
+func (s *CharacterService) AddCondition(name, condition string, levels int) error {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(strings.TrimSpace(condition), domain.ConditionExhaustion) {
+		err = c.AddExhaustion(levels)
+	} else {
+		err = c.AddCondition(condition)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Save(c)
+}
+
+// RemoveCondition ends a condition on a character; exhaustion is lowered by levels instead
+func (s *CharacterService) RemoveCondition(name, condition string, levels int) error {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(strings.TrimSpace(condition), domain.ConditionExhaustion) {
+		err = c.RemoveExhaustion(levels)
+	} else {
+		err = c.RemoveCondition(condition)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Save(c)
+}
+
+// AddItem puts an item from the equipment catalog into a character's inventory
+func (s *CharacterService) AddItem(name, item string, quantity int, notes string) (domain.InventoryItem, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.InventoryItem{}, err
+	}
+
+	entry := domain.InventoryItem{Name: strings.TrimSpace(item), Quantity: quantity, Notes: notes}
+	if err := s.applyCatalog(&entry); err != nil {
+		return domain.InventoryItem{}, err
+	}
+
+	added, err := c.AddItem(entry)
+	if err != nil {
+		return added, err
+	}
+
+	return added, s.repo.Save(c)
+}
+
+// applyCatalog fills in an inventory item's name, category and weight from the equipment catalog
+// A magic bonus prefix such as "+1 Longsword" is kept on the name; without a catalog the item is kept as given
+func (s *CharacterService) applyCatalog(item *domain.InventoryItem) error {
+	if s.equipment == nil {
+		return nil
+	}
+	if _, err := s.equipment.LoadAll(); err != nil {
+		return fmt.Errorf("failed to load equipment catalog: %w", err)
+	}
+
+	baseName, bonus := domain.ParseMagicBonus(item.Name)
+	eq, err := s.equipment.FindByName(baseName)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrUnknownItem, item.Name)
+	}
+	item.Name, item.Category, item.Weight = eq.Name, eq.Category, eq.Weight
+	if bonus > 0 {
+		item.Name = fmt.Sprintf("+%d %s", bonus, eq.Name)
+	}
+	return nil
+}
+
+// SetVariantEncumbrance turns the optional variant encumbrance rule on or off for a character
+func (s *CharacterService) SetVariantEncumbrance(name string, enabled bool) (*domain.Character, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	c.VariantEncumbrance = enabled
+	return c, s.repo.Save(c)
+}
+
+// RemoveItem takes items out of a character's inventory
+func (s *CharacterService) RemoveItem(name, item string, quantity int) (domain.InventoryItem, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.InventoryItem{}, err
+	}
+
+	removed, err := c.RemoveItem(item, quantity)
+	if err != nil {
+		return removed, err
+	}
+
+	return removed, s.repo.Save(c)
+}
+
+// GiveCoins adds coins such as "15 gp" to a character's purse
+func (s *CharacterService) GiveCoins(name, amount string) (domain.Purse, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.Purse{}, err
+	}
+
+	coins, err := domain.ParseCoins(amount)
+	if err != nil {
+		return domain.Purse{}, err
+	}
+	c.GiveCoins(coins)
+
+	return c.Coins, s.repo.Save(c)
+}
+
+// PayCoins spends coins such as "3 gp 5 sp" from a character's purse, making change as needed
+func (s *CharacterService) PayCoins(name, amount string) (domain.Purse, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.Purse{}, err
+	}
+
+	cost, err := domain.ParseCoins(amount)
+	if err != nil {
+		return domain.Purse{}, err
+	}
+	if err := c.PayCoins(cost); err != nil {
+		return c.Coins, err
+	}
+
+	return c.Coins, s.repo.Save(c)
+}
+
+// EquipCharacter equips a character with weapons, armor, and shields
+// Equipment the character isn't proficient with is returned as warnings, or refused when strict is set
+// The weapon must be in the equipment catalog unless improvised is set to wield an object as an improvised weapon
+func (s *CharacterService) EquipCharacter(name, weapon, armor, shield, weaponSlot string, strict, improvised bool) ([]string, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	notProficient := func(item, penalty string) error {
+		if strict {
+			return fmt.Errorf("%w with %s", domain.ErrNotProficient, item)
 		}
+		warnings = append(warnings, fmt.Sprintf("%s is not proficient with %s: %s", c.Name, item, penalty))
+		return nil
+	}
 
-		c.Weapon = weapon
-		if weaponSlot != "" {
-			c.WeaponSlot = weaponSlot
-		} else {
-			c.WeaponSlot = "main hand" // Default slot
+	if weapon != "" {
+		// Objects that aren't catalog weapons can still be wielded as improvised weapons, without stats
+		var stats *domain.WeaponStats
+		if !improvised {
+			found, err := s.findWeapon(weapon)
+			if err != nil {
+				return nil, err
+			}
+			stats = &found
+		}
+		if stats != nil && !c.IsProficientWithWeapon(*stats) {
+			if err := notProficient(stats.Name, "attacks don't add the proficiency bonus"); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.Equip(weapon, stats, weaponSlot); err != nil {
+			return nil, err
 		}
 	}
+
+	// Armor class comes from the catalog stats, so armor and shields must be known items
+	const armorPenalty = "disadvantage on Str and Dex checks, saves and attack rolls, and no spellcasting"
 	if armor != "" {
-		c.Armor = armor
+		stats, err := s.findArmor(armor)
+		if err != nil {
+			return nil, err
+		}
+		if !c.IsProficientWithArmor(stats) {
+			if err := notProficient(stats.Name, armorPenalty); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.WearArmor(stats); err != nil {
+			return nil, err
+		}
 	}
 	if shield != "" {
-		c.Shield = shield
+		stats, err := s.findArmor(shield)
+		if err != nil {
+			return nil, err
+		}
+		if !c.IsProficientWithArmor(stats) {
+			if err := notProficient(stats.Name, armorPenalty); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.WearShield(stats); err != nil {
+			return nil, err
+		}
 	}
 
-	return s.repo.Save(c)
+	return warnings, s.repo.Save(c)
+}
+
+// UnequipCharacter empties an equipment slot (main hand, off hand, armor or shield) and returns what was in it
+func (s *CharacterService) UnequipCharacter(name, slot string) (string, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return "", err
+	}
+
+	item, err := c.Unequip(slot)
+	if err != nil {
+		return "", err
+	}
+
+	return item, s.repo.Save(c)
+}
+
+// SwapWeapons exchanges the weapons in a character's main hand and off hand
+func (s *CharacterService) SwapWeapons(name string) (*domain.Character, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SwapWeapons(); err != nil {
+		return nil, err
+	}
+
+	return c, s.repo.Save(c)
+}
+
+// findWeapon looks up a weapon in the equipment catalog
+func (s *CharacterService) findWeapon(name string) (domain.WeaponStats, error) {
+	if s.equipment == nil {
+		return domain.WeaponStats{}, fmt.Errorf("%w: no equipment catalog to look up %q", domain.ErrUnknownWeapon, name)
+	}
+	return domain.FindWeapon(s.equipment, name)
+}
+
+// findArmor looks up armor or a shield in the equipment catalog
+func (s *CharacterService) findArmor(name string) (domain.ArmorStats, error) {
+	if s.equipment == nil {
+		return domain.ArmorStats{}, fmt.Errorf("%w: no equipment catalog to look up %q", domain.ErrUnknownArmor, name)
+	}
+	return domain.FindArmor(s.equipment, name)
+}
+
+// Attack rolls an attack with a character's main-hand or off-hand weapon, spending ammunition where needed
+// powerAttack takes -5 to hit for +10 damage with Great Weapon Master or Sharpshooter
+func (s *CharacterService) Attack(name string, offHand, powerAttack bool) (domain.AttackResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.AttackResult{}, err
+	}
+
+	result, err := c.MakeAttack(offHand, powerAttack, s.roller.Roll)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// RecoverAmmunition gives a character back half of the ammunition spent since the last recovery
+func (s *CharacterService) RecoverAmmunition(name string) ([]domain.InventoryItem, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	recovered, err := c.RecoverAmmunition()
+	if err != nil {
+		return recovered, err
+	}
+
+	return recovered, s.repo.Save(c)
+}
+
+// UseItem uses up one of a character's potions or scrolls and applies its effect
+func (s *CharacterService) UseItem(name, item string) (domain.ConsumableResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.ConsumableResult{}, err
+	}
+
+	result, err := c.UseConsumable(item, s.roller.Roll)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// Attune attunes a character to one of their magic items
+func (s *CharacterService) Attune(name, item string) (domain.MagicItem, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.MagicItem{}, err
+	}
+
+	magicItem, err := c.Attune(item)
+	if err != nil {
+		return magicItem, err
+	}
+
+	return magicItem, s.repo.Save(c)
+}
+
+// Unattune ends a character's attunement to a magic item
+func (s *CharacterService) Unattune(name, item string) (domain.MagicItem, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.MagicItem{}, err
+	}
+
+	magicItem, err := c.Unattune(item)
+	if err != nil {
+		return magicItem, err
+	}
+
+	return magicItem, s.repo.Save(c)
 }
 
 // LearnSpell adds a spell to a character's known spells
-func (s *CharacterService) LearnSpell(name, spell string) error {
+// offList allows a spell from outside the class spell list, e.g. from Magical Secrets
+func (s *CharacterService) LearnSpell(name, spellName string, offList bool) error {
 	c, err := s.repo.Load(name)
 	if err != nil {
 		return err
@@ -186,23 +731,89 @@ func (s *CharacterService) LearnSpell(name, spell string) error {
 	}
 
 	// Check if this is a prepared caster (they can't learn spells, only prepare them)
-	if c.IsPreparedCaster() {
+	if !c.IsKnownCaster() {
 		return errors.New("this class prepares spells and can't learn them")
 	}
 
+	spell, err := s.findSpell(spellName)
+	if err != nil {
+		return err
+	}
+	if err := c.CheckSpellAccess(*spell, offList); err != nil {
+		return err
+	}
+
 	// Check if spell is already known
 	for _, knownSpell := range c.KnownSpells {
-		if strings.EqualFold(knownSpell, spell) {
+		if strings.EqualFold(knownSpell, spell.Name) {
 			return errors.New("spell already known")
 		}
 	}
 
-	c.KnownSpells = append(c.KnownSpells, spell)
+	if err := c.CheckSpellsKnown(*spell, s.lookupSpells(c.KnownSpells)); err != nil {
+		return err
+	}
+
+	c.KnownSpells = append(c.KnownSpells, spell.Name)
 	return s.repo.Save(c)
 }
 
+// ForgetSpell removes a spell from a character's known spells and returns its name
+func (s *CharacterService) ForgetSpell(name, spellName string) (string, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return "", err
+	}
+
+	forgotten, err := c.ForgetSpell(spellName)
+	if err != nil {
+		return "", err
+	}
+
+	return forgotten, s.repo.Save(c)
+}
+
+// SwapSpell replaces a known spell with a new one, as classes that learn spells may do when they gain a level
+// offList allows a new spell from outside the class spell list, e.g. from Magical Secrets
+func (s *CharacterService) SwapSpell(name, oldSpell, newSpell string, offList bool) (string, string, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !c.IsKnownCaster() {
+		return "", "", errors.New("this class prepares spells and can't learn them")
+	}
+
+	spell, err := s.findSpell(newSpell)
+	if err != nil {
+		return "", "", err
+	}
+	forgotten, err := c.ForgetSpell(oldSpell)
+	if err != nil {
+		return "", "", err
+	}
+	if err := c.CheckSpellAccess(*spell, offList); err != nil {
+		return "", "", err
+	}
+	for _, knownSpell := range c.KnownSpells {
+		if strings.EqualFold(knownSpell, spell.Name) {
+			return "", "", errors.New("spell already known")
+		}
+	}
+
+	// Swapping a cantrip for a spell of 1st level or higher, or the other way around, changes which limit applies
+	if err := c.CheckSpellsKnown(*spell, s.lookupSpells(c.KnownSpells)); err != nil {
+		return "", "", err
+	}
+
+	c.KnownSpells = append(c.KnownSpells, spell.Name)
+	return forgotten, spell.Name, s.repo.Save(c)
+}
+
 // PrepareSpell adds a spell to a character's prepared spells
-func (s *CharacterService) PrepareSpell(name, spellName string) error {
+// offList allows a spell from outside the class spell list, e.g. a domain spell
+func (s *CharacterService) PrepareSpell(name, spellName string, offList bool) error {
 	c, err := s.repo.Load(name)
 	if err != nil {
 		return err
@@ -218,51 +829,130 @@ func (s *CharacterService) PrepareSpell(name, spellName string) error {
 		return errors.New("this class learns spells and can't prepare them")
 	}
 
-	// Check if character has spell slots for this spell level
-	spellLevel := spell.GetSpellLevel(spellName)
-	if spellLevel > 0 { // Only check for leveled spells (not cantrips)
-		if slots, hasSlots := c.SpellSlots[spellLevel]; !hasSlots || slots == 0 {
-			return errors.New("the spell has higher level than the available spell slots")
-		}
+	spell, err := s.findSpell(spellName)
+	if err != nil {
+		return err
+	}
+
+	// Check the class spell list and that the character has spell slots for this spell level
+	if err := c.CheckSpellAccess(*spell, offList); err != nil {
+		return err
 	}
 
 	// Check if spell is already prepared
 	for _, preparedSpell := range c.PreparedSpells {
-		if strings.EqualFold(preparedSpell, spellName) {
+		if strings.EqualFold(preparedSpell, spell.Name) {
 			return errors.New("spell already prepared")
 		}
 	}
 
-	c.PreparedSpells = append(c.PreparedSpells, spellName)
+	if err := c.CheckSpellsPrepared(*spell, s.lookupSpells(c.PreparedSpells)); err != nil {
+		return err
+	}
+
+	c.PreparedSpells = append(c.PreparedSpells, spell.Name)
 	return s.repo.Save(c)
 }
 
-// CastSpell casts a spell, consuming the appropriate spell slot
-func (s *CharacterService) CastSpell(name, spellName string) error {
+// UnprepareSpell removes a spell from a character's prepared spells and returns its name
+func (s *CharacterService) UnprepareSpell(name, spellName string) (string, error) {
 	c, err := s.repo.Load(name)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	unprepared, err := c.UnprepareSpell(spellName)
+	if err != nil {
+		return "", err
+	}
+
+	return unprepared, s.repo.Save(c)
+}
+
+// CastSpell casts a spell, consuming a spell slot of slotLevel, or the lowest available slot if slotLevel is 0
+func (s *CharacterService) CastSpell(name, spellName string, slotLevel int) (domain.SpellCastResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.SpellCastResult{}, err
 	}
 
 	// Check if class can cast spells
 	if !c.IsSpellcaster() {
-		return errors.New("this class can't cast spells")
+		return domain.SpellCastResult{}, errors.New("this class can't cast spells")
 	}
 
 	// Get the spell level
-	spellLevel := spell.GetSpellLevel(spellName)
+	spell, err := s.findSpell(spellName)
+	if err != nil {
+		return domain.SpellCastResult{}, err
+	}
 
-	// Attempt to cast the spell (consumes spell slot)
-	if err := c.CastSpell(spellLevel); err != nil {
-		return err
+	// Attempt to cast the spell (consumes spell slot and starts concentrating if needed)
+	result, err := c.Cast(*spell, slotLevel)
+	if err != nil {
+		return result, err
+	}
+
+	// A higher-level slot can strengthen the spell, as described in the catalog's "At Higher Levels" text
+	if result.SlotLevel > spell.Level {
+		result.UpcastEffect = spelldomain.UpcastEffect(spell.HigherLevel, result.SlotLevel)
 	}
 
 	// Save the updated character
-	return s.repo.Save(c)
+	return result, s.repo.Save(c)
+}
+
+// ConcentrationCheck rolls a character's Constitution saving throw to keep concentrating after taking damage
+func (s *CharacterService) ConcentrationCheck(name string, damage int) (domain.ConcentrationResult, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return domain.ConcentrationResult{}, err
+	}
+
+	result, err := c.ConcentrationCheck(damage, s.roller.Roll)
+	if err != nil {
+		return result, err
+	}
+
+	return result, s.repo.Save(c)
+}
+
+// EndConcentration stops a character concentrating and returns the spell that ended
+func (s *CharacterService) EndConcentration(name string) (string, error) {
+	c, err := s.repo.Load(name)
+	if err != nil {
+		return "", err
+	}
+
+	ended, err := c.EndConcentration()
+	if err != nil {
+		return "", err
+	}
+
+	return ended, s.repo.Save(c)
 }
 
-// generateSkillProficiencies creates skill list based on background and class using domain logic
-func (s *CharacterService) generateSkillProficiencies(background, class string) []string {
+// findSpell looks up a spell in the spell catalog
+func (s *CharacterService) findSpell(name string) (*spelldomain.Spell, error) {
+	return s.spells.FindByName(name)
+}
+
+// lookupSpells looks up a character's spells in the spell catalog to count them against the spell limits
+// Spells missing from the catalog are counted as spells of 1st level or higher
+func (s *CharacterService) lookupSpells(names []string) []spelldomain.Spell {
+	spells := make([]spelldomain.Spell, 0, len(names))
+	for _, name := range names {
+		spell, err := s.findSpell(name)
+		if err != nil {
+			spell = &spelldomain.Spell{Name: name, Level: 1}
+		}
+		spells = append(spells, *spell)
+	}
+	return spells
+}
+
+// generateSkillProficiencies creates skill list based on background, class and race using domain logic
+func (s *CharacterService) generateSkillProficiencies(background, class, race string) []string {
 	bg := domain.NewBackground(background)
 	bgSkills := bg.GetSkillProficiencies()
 
@@ -284,6 +974,17 @@ func (s *CharacterService) generateSkillProficiencies(background, class string)
 		}
 	}
 
+	// Add racial skills such as the elf's Keen Senses, unless another source already gives them
+	for _, racial := range domain.NewRace(race).SkillProficiencies {
+		known := false
+		for _, skill := range skillList {
+			known = known || skill == racial
+		}
+		if !known {
+			skillList = append(skillList, racial)
+		}
+	}
+
 	sort.Strings(skillList)
 	return skillList
 }
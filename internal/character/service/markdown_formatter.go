@@ -2,20 +2,27 @@ package service
 
 import (
 	"DnD-sheet/internal/character/domain"
-	"DnD-sheet/internal/spell"
+	spelldomain "DnD-sheet/internal/spell/domain"
 	"fmt"
 	"sort"
 	"strings"
 )
 
 // MarkdownFormatter handles markdown export formatting
-type MarkdownFormatter struct{}
+type MarkdownFormatter struct {
+	spells spelldomain.SpellRepository // optional catalog used to group spells by level
+}
 
 // NewMarkdownFormatter creates a new markdown formatter
 func NewMarkdownFormatter() *MarkdownFormatter {
 	return &MarkdownFormatter{}
 }
 
+// NewMarkdownFormatterWithSpells creates a markdown formatter that looks up spell levels in a spell catalog
+func NewMarkdownFormatterWithSpells(spells spelldomain.SpellRepository) *MarkdownFormatter {
+	return &MarkdownFormatter{spells: spells}
+}
+
 // FormatCharacter formats a character as markdown
 func (f *MarkdownFormatter) FormatCharacter(char *domain.Character) string {
 	var builder strings.Builder
@@ -25,23 +32,76 @@ func (f *MarkdownFormatter) FormatCharacter(char *domain.Character) string {
 
 	// Character section
 	builder.WriteString("## Character\n")
-	builder.WriteString(fmt.Sprintf("Class: %s\n", char.Class))
+	builder.WriteString(fmt.Sprintf("Class: %s\n", char.ClassSummary()))
 	builder.WriteString(fmt.Sprintf("Race: %s\n", char.Race))
 	builder.WriteString(fmt.Sprintf("Background: %s\n", char.Background))
 	builder.WriteString(fmt.Sprintf("Level: %d\n", char.Level))
 	builder.WriteString(fmt.Sprintf("Proficiency bonus: +%d\n", char.ProficiencyBonus))
 
 	// Use domain method for passive perception
-	builder.WriteString(fmt.Sprintf("Passive perception: %d\n\n", char.PassivePerception()))
+	builder.WriteString(fmt.Sprintf("Passive perception: %d\n", char.PassivePerception()))
+
+	// Racial details
+	race := domain.NewRace(char.Race)
+	builder.WriteString(fmt.Sprintf("Size: %s\n", race.Size))
+	if race.Darkvision > 0 {
+		builder.WriteString(fmt.Sprintf("Darkvision: %d ft\n", race.Darkvision))
+	}
+	builder.WriteString(fmt.Sprintf("Languages: %s\n\n", strings.Join(race.Languages, ", ")))
 
 	// Ability scores
 	builder.WriteString("## Ability scores\n")
-	builder.WriteString(fmt.Sprintf("STR: %d (%s)\n", char.Str, f.formatModifier(domain.Modifier(char.Str))))
-	builder.WriteString(fmt.Sprintf("DEX: %d (%s)\n", char.Dex, f.formatModifier(domain.Modifier(char.Dex))))
-	builder.WriteString(fmt.Sprintf("CON: %d (%s)\n", char.Con, f.formatModifier(domain.Modifier(char.Con))))
-	builder.WriteString(fmt.Sprintf("INT: %d (%s)\n", char.Int, f.formatModifier(domain.Modifier(char.Int))))
-	builder.WriteString(fmt.Sprintf("WIS: %d (%s)\n", char.Wis, f.formatModifier(domain.Modifier(char.Wis))))
-	builder.WriteString(fmt.Sprintf("CHA: %d (%s)\n\n", char.Cha, f.formatModifier(domain.Modifier(char.Cha))))
+	for _, ability := range domain.Abilities {
+		score := char.AbilityScore(ability)
+		builder.WriteString(fmt.Sprintf("%s: %d (%s)\n", ability, score, f.formatModifier(domain.Modifier(score))))
+	}
+	builder.WriteString("\n")
+
+	// Saving throws
+	builder.WriteString("## Saving throws\n")
+	builder.WriteString(f.formatSavingThrows(char))
+	builder.WriteString("\n")
+
+	// Racial traits
+	if len(race.Traits) > 0 {
+		builder.WriteString("## Racial traits\n")
+		for _, trait := range race.Traits {
+			builder.WriteString(fmt.Sprintf("- %s: %s\n", trait.Name, trait.Description))
+		}
+		builder.WriteString("\n")
+	}
+
+	// Class features
+	if features := char.Features(); len(features) > 0 {
+		builder.WriteString("## Class features\n")
+		for _, feature := range features {
+			builder.WriteString(fmt.Sprintf("- %s: %s\n", feature, feature.Description))
+		}
+		if char.FightingStyle != "" {
+			builder.WriteString(fmt.Sprintf("- Fighting style: %s\n", char.FightingStyle))
+		}
+		builder.WriteString("\n")
+	}
+
+	// Limited-use class resources
+	if len(char.Resources) > 0 {
+		builder.WriteString("## Resources\n")
+		for _, r := range char.Resources {
+			builder.WriteString(fmt.Sprintf("- %s: %d/%d (%s)\n", r.Name, r.Current, r.Max, r.Recharge))
+		}
+		builder.WriteString("\n")
+	}
+
+	// Feats
+	if len(char.Feats) > 0 {
+		builder.WriteString("## Feats\n")
+		for _, taken := range char.Feats {
+			if feat, ok := domain.LookupFeat(taken.Name); ok {
+				builder.WriteString(fmt.Sprintf("- %s: %s\n", taken, feat.Description))
+			}
+		}
+		builder.WriteString("\n")
+	}
 
 	// Skills
 	builder.WriteString("## Skills\n")
@@ -53,18 +113,73 @@ func (f *MarkdownFormatter) FormatCharacter(char *domain.Character) string {
 	if char.Weapon != "" {
 		builder.WriteString(fmt.Sprintf("Main hand: %s\n", char.Weapon))
 	}
+	if char.OffHand != "" {
+		builder.WriteString(fmt.Sprintf("Off hand: %s\n", char.OffHand))
+	}
 	if char.Armor != "" {
 		builder.WriteString(fmt.Sprintf("Armor: %s\n", char.Armor))
 	}
 	if char.Shield != "" {
 		builder.WriteString(fmt.Sprintf("Shield: %s\n", char.Shield))
 	}
+	if warning := char.ArmorProficiencyWarning(); warning != "" {
+		builder.WriteString(warning + "\n")
+	}
 	builder.WriteString("\n")
 
+	// Weapon attacks
+	if attacks := char.WeaponAttacks(); len(attacks) > 0 {
+		builder.WriteString("## Attacks\n")
+		for _, attack := range attacks {
+			builder.WriteString(fmt.Sprintf("- %s\n", attack))
+		}
+		builder.WriteString("\n")
+	}
+
+	// Inventory and coins
+	if len(char.Inventory) > 0 || !char.Coins.IsEmpty() {
+		builder.WriteString("## Inventory\n")
+		for _, item := range char.Inventory {
+			builder.WriteString(fmt.Sprintf("- %s\n", item))
+		}
+		if len(char.Attuned) > 0 {
+			builder.WriteString(fmt.Sprintf("Attuned: %s (%d/%d)\n", strings.Join(char.Attuned, ", "), len(char.Attuned), domain.MaxAttunedItems))
+		}
+		builder.WriteString(fmt.Sprintf("Coins: %s\n", char.Coins))
+		builder.WriteString(fmt.Sprintf("Carried weight: %g/%g lb (%s)\n\n", char.CarriedWeight(), char.CarryingCapacity(), char.Encumbrance()))
+	}
+
 	// Combat stats
 	builder.WriteString("## Combat stats\n")
 	builder.WriteString(fmt.Sprintf("Armor class: %d\n", char.ArmorClass()))
-	builder.WriteString(fmt.Sprintf("Initiative bonus: %s\n\n", f.formatModifier(domain.Modifier(char.Dex))))
+	builder.WriteString(fmt.Sprintf("Hit points: %d/%d\n", char.CurrentHP, char.MaxHitPoints()))
+	if char.TempHP > 0 {
+		builder.WriteString(fmt.Sprintf("Temporary hit points: %d\n", char.TempHP))
+	}
+	if state := char.LifeState(); state != domain.LifeStateConscious {
+		builder.WriteString(fmt.Sprintf("Status: %s\n", state))
+	}
+	if char.LifeState() == domain.LifeStateDying {
+		builder.WriteString(fmt.Sprintf("Death saves: %d successes, %d failures\n", char.DeathSaves.Successes, char.DeathSaves.Failures))
+	}
+	builder.WriteString(fmt.Sprintf("Hit dice: %d/%s\n", char.HitDice.Remaining(), char.HitDice))
+	builder.WriteString(fmt.Sprintf("Initiative bonus: %s\n", f.formatModifier(char.Initiative())))
+	builder.WriteString(fmt.Sprintf("Speed: %d ft\n\n", char.Speed()))
+
+	// Conditions and the rolls they affect
+	if summary := char.ConditionSummary(); len(summary) > 0 {
+		builder.WriteString("## Conditions\n")
+		for _, line := range summary {
+			builder.WriteString(fmt.Sprintf("- %s\n", line))
+		}
+		if mode := char.AttackRollMode().String(); mode != "" {
+			builder.WriteString(fmt.Sprintf("Attack rolls: %s\n", mode))
+		}
+		if summary := char.AbilityCheckSummary(); summary != "" {
+			builder.WriteString(fmt.Sprintf("Ability checks: %s\n", summary))
+		}
+		builder.WriteString("\n")
+	}
 
 	// Spell slots (only for casters)
 	if char.IsSpellcaster() {
@@ -74,13 +189,20 @@ func (f *MarkdownFormatter) FormatCharacter(char *domain.Character) string {
 				builder.WriteString(fmt.Sprintf("Level %d: %d\n", level, slots))
 			}
 		}
+		for level, slots := range char.PactSlots {
+			builder.WriteString(fmt.Sprintf("Pact Magic (level %d): %d\n", level, slots))
+		}
 		builder.WriteString("\n")
 
 		// Spellcasting
 		builder.WriteString("## Spellcasting\n")
 		builder.WriteString(fmt.Sprintf("Spellcasting ability: %s\n", char.SpellcastingAbility()))
 		builder.WriteString(fmt.Sprintf("Spell save DC: %d\n", char.SpellSaveDC()))
-		builder.WriteString(fmt.Sprintf("Spell attack bonus: +%d\n\n", char.SpellAttackBonus()))
+		builder.WriteString(fmt.Sprintf("Spell attack bonus: +%d\n", char.SpellAttackBonus()))
+		if char.Concentration != "" {
+			builder.WriteString(fmt.Sprintf("Concentrating on: %s\n", char.Concentration))
+		}
+		builder.WriteString("\n")
 
 		// Spells
 		if len(char.PreparedSpells) > 0 {
@@ -122,6 +244,23 @@ func (f *MarkdownFormatter) formatSkills(char *domain.Character) string {
 	return builder.String()
 }
 
+// formatSavingThrows formats the saving throws with checkboxes for class proficiencies
+func (f *MarkdownFormatter) formatSavingThrows(char *domain.Character) string {
+	var builder strings.Builder
+	for _, ability := range domain.Abilities {
+		check := "[]"
+		if char.IsProficientInSave(ability) {
+			check = "[x]"
+		}
+		mode := ""
+		if m := char.SavingThrowMode(ability).String(); m != "" {
+			mode = " (" + m + ")"
+		}
+		builder.WriteString(fmt.Sprintf("* %s %s: %s%s\n", check, ability, f.formatModifier(char.SavingThrow(ability)), mode))
+	}
+	return builder.String()
+}
+
 // hasSkillProficiency checks if a character has proficiency in a skill
 func (f *MarkdownFormatter) hasSkillProficiency(proficiencies []string, skill string) bool {
 	skill = strings.ToLower(skill)
@@ -139,12 +278,17 @@ func (f *MarkdownFormatter) formatSpellsByLevel(spells []string) string {
 		return ""
 	}
 
-	// For now, we'll use a simple heuristic to determine spell levels
-	// In a real implementation, you'd look this up from a spell database
+	// Spells missing from the spell catalog, or without one, are listed under an unknown level
+	const unknownLevel = -1
 	spellLevels := make(map[int][]string)
 
 	for _, spellName := range spells {
-		level := spell.GetSpellLevel(spellName)
+		level := unknownLevel
+		if f.spells != nil {
+			if spell, err := f.spells.FindByName(spellName); err == nil {
+				level = spell.Level
+			}
+		}
 		spellLevels[level] = append(spellLevels[level], spellName)
 	}
 
@@ -159,7 +303,11 @@ func (f *MarkdownFormatter) formatSpellsByLevel(spells []string) string {
 
 	for _, level := range levels {
 		spells := spellLevels[level]
-		builder.WriteString(fmt.Sprintf("### %d\n", level))
+		if level == unknownLevel {
+			builder.WriteString("### Unknown level\n")
+		} else {
+			builder.WriteString(fmt.Sprintf("### %d\n", level))
+		}
 		for _, spell := range spells {
 			builder.WriteString(fmt.Sprintf("- %s\n", spell))
 		}
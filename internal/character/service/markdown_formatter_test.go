@@ -2,11 +2,31 @@ package service
 
 import (
 	"DnD-sheet/internal/character/domain"
-	"DnD-sheet/internal/spell"
+	equipmentinfra "DnD-sheet/internal/equipment/infrastructure"
+	spellinfra "DnD-sheet/internal/spell/infrastructure"
 	"strings"
 	"testing"
 )
 
+// testEquipment is the equipment catalog armor stats are looked up in
+var testEquipment = equipmentinfra.NewCSVEquipmentRepository("../../equipment/5e-SRD-Equipment.csv")
+
+// testSpells is the spell catalog spell levels are looked up in
+var testSpells = spellinfra.NewCSVSpellRepository("../../spell/5e-SRD-Spells.csv")
+
+// armorStats looks up armor for a test character, or returns nil for no armor
+func armorStats(t *testing.T, name string) *domain.ArmorStats {
+	t.Helper()
+	if name == "" {
+		return nil
+	}
+	stats, err := domain.FindArmor(testEquipment, name)
+	if err != nil {
+		t.Fatalf("Unexpected error looking up %q: %v", name, err)
+	}
+	return &stats
+}
+
 func TestMarkdownFormatter_FormatCharacter(t *testing.T) {
 	formatter := NewMarkdownFormatter()
 
@@ -34,6 +54,8 @@ func TestMarkdownFormatter_FormatCharacter(t *testing.T) {
 				Weapon:             "longsword",
 				Armor:              "chain mail",
 				Shield:             "shield",
+				ArmorStats:         armorStats(t, "chain mail"),
+				ShieldStats:        armorStats(t, "shield"),
 			},
 			expected: []string{
 				"# Test Fighter",
@@ -46,6 +68,9 @@ func TestMarkdownFormatter_FormatCharacter(t *testing.T) {
 				"Armor: chain mail",
 				"Shield: shield",
 				"Armor class: 18",
+				"[x] STR: +5",
+				"[x] CON: +4",
+				"[] DEX: +2",
 			},
 		},
 		{
@@ -104,6 +129,27 @@ func TestMarkdownFormatter_FormatCharacter(t *testing.T) {
 				"## Combat stats",
 			},
 		},
+		{
+			name: "Alert Feat Initiative",
+			char: &domain.Character{
+				Name:             "Test Rogue",
+				Class:            "rogue",
+				Race:             "human",
+				Background:       "criminal",
+				Level:            4,
+				Str:              10,
+				Dex:              14,
+				Con:              12,
+				Int:              10,
+				Wis:              12,
+				Cha:              10,
+				ProficiencyBonus: 2,
+				Feats:            []domain.CharacterFeat{{Name: "Alert"}},
+			},
+			expected: []string{
+				"Initiative bonus: +7", // Dex +2 and Alert +5
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -161,19 +207,22 @@ func TestMarkdownFormatter_ArmorClass(t *testing.T) {
 		dex      int
 		expected int
 	}{
-		{name: "No Armor", armor: "", shield: "", dex: 14, expected: 12},                            // 10 + 2 dex
-		{name: "Leather + Shield", armor: "leather armor", shield: "shield", dex: 14, expected: 15}, // 11 + 2 dex + 2 shield
-		{name: "Chain Mail", armor: "chain mail", shield: "", dex: 14, expected: 16},                // 16 base (dex ignored)
-		{name: "Chain Shirt High Dex", armor: "chain shirt", shield: "", dex: 18, expected: 15},     // 13 + 2 (max dex)
-		{name: "Plate + Shield", armor: "plate", shield: "shield", dex: 10, expected: 20},           // 18 + 2 shield
+		{name: "No Armor", armor: "", shield: "", dex: 14, expected: 12},                               // 10 + 2 dex
+		{name: "Leather + Shield", armor: "leather armor", shield: "shield", dex: 14, expected: 15},    // 11 + 2 dex + 2 shield
+		{name: "Chain Mail", armor: "chain mail", shield: "", dex: 14, expected: 16},                   // 16 base (dex ignored)
+		{name: "Chain Shirt High Dex", armor: "chain shirt", shield: "", dex: 18, expected: 15},        // 13 + 2 (max dex)
+		{name: "Plate + Shield", armor: "plate", shield: "shield", dex: 10, expected: 20},              // 18 + 2 shield
+		{name: "Magic Half Plate", armor: "+1 half plate", shield: "+2 shield", dex: 16, expected: 22}, // 15 + 1 + 2 (max dex) + 2 + 2
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			char := &domain.Character{
-				Armor:  tt.armor,
-				Shield: tt.shield,
-				Dex:    tt.dex,
+				Armor:       tt.armor,
+				Shield:      tt.shield,
+				ArmorStats:  armorStats(t, tt.armor),
+				ShieldStats: armorStats(t, tt.shield),
+				Dex:         tt.dex,
 			}
 
 			ac := char.ArmorClass()
@@ -185,20 +234,12 @@ func TestMarkdownFormatter_ArmorClass(t *testing.T) {
 }
 
 func TestMarkdownFormatter_SpellLevels(t *testing.T) {
-	tests := []struct {
-		spell    string
-		expected int
-	}{
-		{"command", 1},
-		{"beacon of hope", 3},
-		{"unknown spell", 1}, // default
-	}
+	formatter := NewMarkdownFormatterWithSpells(testSpells)
+	output := formatter.formatSpellsByLevel([]string{"beacon of hope", "command", "Healing Word", "unknown spell"})
 
-	for _, tt := range tests {
-		level := spell.GetSpellLevel(tt.spell)
-		if level != tt.expected {
-			t.Errorf("Expected spell %q to be level %d, got %d", tt.spell, tt.expected, level)
-		}
+	expected := "### Unknown level\n- unknown spell\n\n### 1\n- command\n- Healing Word\n\n### 3\n- beacon of hope\n\n"
+	if output != expected {
+		t.Errorf("Expected spells grouped by catalog level:\n%s\ngot:\n%s", expected, output)
 	}
 }
 
@@ -1,14 +1,19 @@
 package cli
 
 import (
-	"DnD-sheet/internal/equipment"
-	"DnD-sheet/internal/spell"
+	equipmentdomain "DnD-sheet/internal/equipment/domain"
+	equipmentservice "DnD-sheet/internal/equipment/service"
+	spelldomain "DnD-sheet/internal/spell/domain"
+	spellservice "DnD-sheet/internal/spell/service"
 	"fmt"
+	"strings"
 )
 
 // APITestCommand tests the D&D 5e API integration
 type APITestCommand struct {
 	*BaseCommand
+	equipmentService *equipmentservice.EquipmentService
+	spells           spelldomain.SpellRepository
 
 	// Flags
 	spellTest     *bool
@@ -20,9 +25,11 @@ type APITestCommand struct {
 }
 
 // NewAPITestCommand creates a new API test command
-func NewAPITestCommand() *APITestCommand {
+func NewAPITestCommand(equipmentService *equipmentservice.EquipmentService, spells spelldomain.SpellRepository) *APITestCommand {
 	cmd := &APITestCommand{
-		BaseCommand: NewBaseCommand("api-test"),
+		BaseCommand:      NewBaseCommand("api-test"),
+		equipmentService: equipmentService,
+		spells:           spells,
 	}
 
 	// Define flags
@@ -41,6 +48,11 @@ func (c *APITestCommand) Name() string {
 	return "api-test"
 }
 
+// Usage prints api-test command usage
+func (c *APITestCommand) Usage() {
+	fmt.Println("  api-test -spells|-equipment [-class CLASS] [-type weapon|armor] [-query QUERY] [-limit N]")
+}
+
 // Execute runs the API test
 func (c *APITestCommand) Execute() error {
 	// Limit to max 10 items for development testing
@@ -74,22 +86,20 @@ func (c *APITestCommand) testSpellEnrichment() error {
 	fmt.Printf("Limit: %d spells\n\n", *c.limit)
 
 	// Create spell enrichment service
-	spellService := spell.NewEnrichmentService()
+	spellService := spellservice.NewEnrichmentService(c.spells)
 	defer spellService.Close()
 
-	csvPath := "internal/spell/5e-SRD-Spells.csv"
-
-	var enrichedSpells []spell.EnrichedSpell
+	var enrichedSpells []spelldomain.EnrichedSpell
 	var err error
 
 	if *c.query != "" {
 		// Search for specific spells
 		fmt.Printf("Searching for spells matching '%s'...\n", *c.query)
-		enrichedSpells, err = spellService.SearchSpells(csvPath, *c.query, *c.limit)
+		enrichedSpells, err = spellService.SearchSpells(*c.query, *c.limit)
 	} else {
 		// Get spells by class
 		fmt.Printf("Getting %s spells...\n", *c.spellClass)
-		enrichedSpells, err = spellService.GetSpellsByClass(csvPath, *c.spellClass, *c.limit)
+		enrichedSpells, err = spellService.GetSpellsByClass(*c.spellClass, *c.limit)
 	}
 
 	if err != nil {
@@ -105,8 +115,8 @@ func (c *APITestCommand) testSpellEnrichment() error {
 
 	for i, spell := range enrichedSpells {
 		fmt.Printf("=== Spell %d: %s ===\n", i+1, spell.Name)
-		fmt.Printf("Level: %d\n", spell.LevelInt)
-		fmt.Printf("Class: %s\n", spell.Class)
+		fmt.Printf("Level: %d\n", spell.Level)
+		fmt.Printf("Class: %s\n", strings.Join(spell.Classes, ", "))
 
 		if spell.School != "" {
 			fmt.Printf("School: %s\n", spell.School)
@@ -161,29 +171,27 @@ func (c *APITestCommand) testEquipmentEnrichment() error {
 	fmt.Printf("Limit: %d items\n\n", *c.limit)
 
 	// Create equipment enrichment service
-	equipmentService := equipment.NewEnrichmentService()
-	defer equipmentService.Close()
-
-	csvPath := "internal/equipment/5e-SRD-Equipment.csv"
+	enrichmentService := equipmentservice.NewEnrichmentService(c.equipmentService)
+	defer enrichmentService.Close()
 
-	var enrichedEquipment []equipment.EnrichedEquipment
+	var enrichedEquipment []equipmentdomain.Equipment
 	var err error
 
 	if *c.query != "" {
 		// Search for specific equipment
 		fmt.Printf("Searching for equipment matching '%s'...\n", *c.query)
-		enrichedEquipment, err = equipmentService.SearchEquipment(csvPath, *c.query, *c.limit)
+		enrichedEquipment, err = enrichmentService.SearchEquipment(*c.query, *c.limit)
 	} else if *c.equipmentType == "weapon" {
 		// Get weapons
 		fmt.Printf("Getting weapons...\n")
-		enrichedEquipment, err = equipmentService.GetWeapons(csvPath, *c.limit)
+		enrichedEquipment, err = enrichmentService.GetWeapons(*c.limit)
 	} else if *c.equipmentType == "armor" {
 		// Get armor
 		fmt.Printf("Getting armor...\n")
-		enrichedEquipment, err = equipmentService.GetArmor(csvPath, *c.limit)
+		enrichedEquipment, err = enrichmentService.GetArmor(*c.limit)
 	} else {
 		// Search by type
-		enrichedEquipment, err = equipmentService.SearchEquipment(csvPath, *c.equipmentType, *c.limit)
+		enrichedEquipment, err = enrichmentService.SearchEquipment(*c.equipmentType, *c.limit)
 	}
 
 	if err != nil {
@@ -212,7 +220,7 @@ func (c *APITestCommand) testEquipmentEnrichment() error {
 				}
 				fmt.Println(" ft")
 			}
-			if eq.TwoHanded {
+			if eq.HasProperty("Two-Handed") {
 				fmt.Printf("Two-Handed: Yes\n")
 			}
 			if eq.Damage != "" {
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ASICommand handles spending Ability Score Improvements on ability scores or feats
+type ASICommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name      *string
+	abilities *string
+	feat      *string
+	list      *bool
+}
+
+// NewASICommand creates a new asi command
+func NewASICommand(characterService *service.CharacterService) *ASICommand {
+	cmd := &ASICommand{
+		BaseCommand:      NewBaseCommand("asi"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.abilities = cmd.flagSet.String("abilities", "", "one ability for +2 (e.g. STR) or two for +1 each (e.g. STR,CON); with -feat, the ability the feat increases")
+	cmd.feat = cmd.flagSet.String("feat", "", "take a feat instead of increasing ability scores")
+	cmd.list = cmd.flagSet.Bool("list", false, "list the available feats")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *ASICommand) Name() string {
+	return "asi"
+}
+
+// Execute applies an Ability Score Improvement or feat
+func (c *ASICommand) Execute() error {
+	if *c.list {
+		for _, name := range domain.FeatNames() {
+			feat, _ := domain.LookupFeat(name)
+			fmt.Printf("%s: %s\n", feat.Name, feat.Description)
+		}
+		return nil
+	}
+
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	var abilities []string
+	if *c.abilities != "" {
+		for _, ability := range strings.Split(*c.abilities, ",") {
+			abilities = append(abilities, strings.TrimSpace(ability))
+		}
+	}
+
+	var err error
+	switch {
+	case *c.feat != "":
+		if len(abilities) > 1 {
+			return fmt.Errorf("a feat increases at most one ability")
+		}
+		ability := ""
+		if len(abilities) == 1 {
+			ability = abilities[0]
+		}
+		err = c.characterService.TakeFeat(*c.name, *c.feat, ability)
+	case len(abilities) > 0:
+		err = c.characterService.ImproveAbilityScores(*c.name, abilities)
+	}
+	if err != nil {
+		return formatASIError(*c.name, err)
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("STR %d, DEX %d, CON %d, INT %d, WIS %d, CHA %d\n",
+		character.Str, character.Dex, character.Con, character.Int, character.Wis, character.Cha)
+	if len(character.Feats) > 0 {
+		fmt.Printf("Feats: %s\n", formatFeats(character.Feats))
+	}
+	fmt.Printf("Pending ability score improvements: %d\n", character.PendingASI)
+
+	return nil
+}
+
+// Usage prints asi command usage
+func (c *ASICommand) Usage() {
+	fmt.Println("  asi -name CHARACTER_NAME [-abilities STR[,DEX]] [-feat FEAT] | asi -list")
+}
+
+// formatASIError converts domain ASI and feat errors into user-friendly CLI messages
+func formatASIError(name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNoPendingASI):
+		return fmt.Errorf("%s has no ability score improvements to spend", name)
+	case errors.Is(err, domain.ErrUnknownFeat):
+		return fmt.Errorf("unknown feat, use asi -list to see the available feats")
+	}
+	return err
+}
+
+// formatFeats joins feats with their chosen abilities for display
+func formatFeats(feats []domain.CharacterFeat) string {
+	names := make([]string, 0, len(feats))
+	for _, feat := range feats {
+		names = append(names, feat.String())
+	}
+	return strings.Join(names, ", ")
+}
@@ -29,36 +29,167 @@ func printSpellSlots(char *domain.Character) {
 			}
 		}
 	}
+	// Warlock Pact Magic has a single slot level
+	for level, slots := range char.PactSlots {
+		fmt.Printf("Pact Magic slots (level %d): %d/%d\n", level, char.CurrentPactSlots[level], slots)
+	}
+}
+
+// printResources prints limited-use class resources with their remaining uses
+func printResources(char *domain.Character) {
+	if len(char.Resources) == 0 {
+		return
+	}
+	fmt.Println("Resources:")
+	for _, r := range char.Resources {
+		fmt.Printf("  %s: %d/%d (%s)\n", r.Name, r.Current, r.Max, r.Recharge)
+	}
+}
+
+// printConditions prints active conditions, exhaustion and the resulting roll modifiers
+func printConditions(char *domain.Character) {
+	summary := char.ConditionSummary()
+	if len(summary) == 0 {
+		return
+	}
+	fmt.Println("Conditions:")
+	for _, line := range summary {
+		fmt.Printf("  - %s\n", line)
+	}
+	if mode := char.AttackRollMode().String(); mode != "" {
+		fmt.Printf("Attack rolls: %s\n", mode)
+	}
+	if summary := char.AbilityCheckSummary(); summary != "" {
+		fmt.Printf("Ability checks: %s\n", summary)
+	}
+}
+
+// printAttacks prints the attack bonus and damage of the wielded weapon
+func printAttacks(char *domain.Character) {
+	attacks := char.WeaponAttacks()
+	if len(attacks) == 0 {
+		return
+	}
+	fmt.Println("Attacks:")
+	for _, attack := range attacks {
+		fmt.Printf("  - %s\n", attack)
+	}
+}
+
+// printInventory prints carried items and coins
+func printInventory(char *domain.Character) {
+	if len(char.Inventory) > 0 {
+		fmt.Println("Inventory:")
+		for _, item := range char.Inventory {
+			fmt.Printf("  - %s\n", item)
+		}
+	}
+	if len(char.Attuned) > 0 {
+		fmt.Printf("Attuned: %s (%d/%d)\n", strings.Join(char.Attuned, ", "), len(char.Attuned), domain.MaxAttunedItems)
+	}
+	if !char.Coins.IsEmpty() {
+		fmt.Printf("Coins: %s\n", char.Coins)
+	}
+	if len(char.Inventory) > 0 {
+		printEncumbrance(char)
+	}
+}
+
+// printHitPoints prints current, maximum and temporary hit points
+func printHitPoints(char *domain.Character) {
+	fmt.Printf("Hit points: %d/%d\n", char.CurrentHP, char.MaxHitPoints())
+	if char.TempHP > 0 {
+		fmt.Printf("Temporary hit points: %d\n", char.TempHP)
+	}
+	if state := char.LifeState(); state != domain.LifeStateConscious {
+		fmt.Printf("Status: %s\n", state)
+	}
+	if char.LifeState() == domain.LifeStateDying {
+		fmt.Printf("Death saves: %d successes, %d failures\n", char.DeathSaves.Successes, char.DeathSaves.Failures)
+	}
 }
 
 // printCharacterInfo prints character information in the expected format
 func (c *ViewCommand) printCharacterInfo(char *domain.Character) {
 	// Print basic info
 	fmt.Printf("Name: %s\n", char.Name)
-	fmt.Printf("Class: %s\n", strings.ToLower(char.Class))
+	fmt.Printf("Class: %s\n", strings.ToLower(char.ClassSummary()))
 	fmt.Printf("Race: %s\n", strings.ToLower(char.Race))
 	fmt.Printf("Background: %s\n", strings.ToLower(char.Background))
 	fmt.Printf("Level: %d\n", char.Level)
 
+	// Print racial details
+	race := domain.NewRace(char.Race)
+	fmt.Printf("Size: %s\n", race.Size)
+	if race.Darkvision > 0 {
+		fmt.Printf("Darkvision: %d ft\n", race.Darkvision)
+	}
+	fmt.Printf("Languages: %s\n", strings.Join(race.Languages, ", "))
+	if len(race.Traits) > 0 {
+		fmt.Println("Racial traits:")
+		for _, trait := range race.Traits {
+			fmt.Printf("  - %s: %s\n", trait.Name, trait.Description)
+		}
+	}
+
+	// Print class features and any choices still to make
+	if features := char.Features(); len(features) > 0 {
+		fmt.Println("Class features:")
+		for _, feature := range features {
+			fmt.Printf("  - %s: %s\n", feature, feature.Description)
+		}
+	}
+	if char.FightingStyle != "" {
+		fmt.Printf("Fighting style: %s\n", char.FightingStyle)
+	}
+	for _, class := range char.ClassesNeedingSubclass() {
+		fmt.Printf("Subclass not chosen for %s (%s)\n", strings.ToLower(class), strings.Join(domain.Subclasses(class), ", "))
+	}
+
 	// Print ability scores
 	fmt.Println("Ability scores:")
-	fmt.Printf("  STR: %d (%+d)\n", char.Str, domain.Modifier(char.Str))
-	fmt.Printf("  DEX: %d (%+d)\n", char.Dex, domain.Modifier(char.Dex))
-	fmt.Printf("  CON: %d (%+d)\n", char.Con, domain.Modifier(char.Con))
-	fmt.Printf("  INT: %d (%+d)\n", char.Int, domain.Modifier(char.Int))
-	fmt.Printf("  WIS: %d (%+d)\n", char.Wis, domain.Modifier(char.Wis))
-	fmt.Printf("  CHA: %d (%+d)\n", char.Cha, domain.Modifier(char.Cha))
+	// Magic items such as Gauntlets of Ogre Power can set a score
+	for _, ability := range domain.Abilities {
+		score := char.AbilityScore(ability)
+		fmt.Printf("  %s: %d (%+d)\n", ability, score, domain.Modifier(score))
+	}
 
 	// Print proficiency bonus (lowercase 'bonus')
 	fmt.Printf("Proficiency bonus: %+d\n", char.ProficiencyBonus)
 
+	// Print saving throws, marking class proficiencies
+	fmt.Println("Saving throws:")
+	for _, ability := range domain.Abilities {
+		var notes []string
+		if char.IsProficientInSave(ability) {
+			notes = append(notes, "proficient")
+		}
+		if mode := char.SavingThrowMode(ability).String(); mode != "" {
+			notes = append(notes, mode)
+		}
+		if len(notes) > 0 {
+			fmt.Printf("  %s: %+d (%s)\n", ability, char.SavingThrow(ability), strings.Join(notes, ", "))
+		} else {
+			fmt.Printf("  %s: %+d\n", ability, char.SavingThrow(ability))
+		}
+	}
+
+	// Print feats and any Ability Score Improvements still to choose
+	if len(char.Feats) > 0 {
+		fmt.Printf("Feats: %s\n", formatFeats(char.Feats))
+	}
+	if char.PendingASI > 0 {
+		fmt.Printf("Pending ability score improvements: %d\n", char.PendingASI)
+	}
+
 	// Print skill proficiencies (just the names, comma-separated)
 	if len(char.SkillProficiencies) > 0 {
 		fmt.Printf("Skill proficiencies: %s\n", strings.Join(char.SkillProficiencies, ", "))
 	}
 
-	// Print spell slots if the character has any
+	// Print spell slots and class resources if the character has any
 	printSpellSlots(char)
+	printResources(char)
 
 	if len(char.SpellSlots) > 0 {
 		// Print spellcasting stats if character can cast spells
@@ -82,6 +213,17 @@ func (c *ViewCommand) printCharacterInfo(char *domain.Character) {
 			fmt.Printf("Spell attack bonus: +%d\n", char.SpellAttackBonus())
 		}
 
+		// Print how many spells the character can know or prepare
+		if cantrips := char.MaxCantrips(); cantrips > 0 {
+			fmt.Printf("Cantrips known: up to %d\n", cantrips)
+		}
+		if char.IsKnownCaster() {
+			fmt.Printf("Spells known: up to %d\n", char.MaxSpellsKnown())
+		}
+		if char.IsPreparedCaster() {
+			fmt.Printf("Prepared spells: up to %d\n", char.MaxPreparedSpells())
+		}
+
 		// Print known spells if the character has any
 		if len(char.KnownSpells) > 0 {
 			fmt.Println("Known spells:")
@@ -97,6 +239,10 @@ func (c *ViewCommand) printCharacterInfo(char *domain.Character) {
 				fmt.Printf("  - %s\n", spell)
 			}
 		}
+
+		if char.Concentration != "" {
+			fmt.Printf("Concentrating on: %s\n", char.Concentration)
+		}
 	}
 
 	// Print equipment information
@@ -109,17 +255,33 @@ func (c *ViewCommand) printCharacterInfo(char *domain.Character) {
 		capitalizedSlot := strings.ToUpper(string(weaponSlot[0])) + weaponSlot[1:]
 		fmt.Printf("%s: %s\n", capitalizedSlot, char.Weapon)
 	}
+	if char.OffHand != "" {
+		fmt.Printf("Off hand: %s\n", char.OffHand)
+	}
 	if char.Armor != "" {
 		fmt.Printf("Armor: %s\n", char.Armor)
 	}
 	if char.Shield != "" {
 		fmt.Printf("Shield: %s\n", char.Shield)
 	}
+	if warning := char.ArmorProficiencyWarning(); warning != "" {
+		fmt.Println(warning)
+	}
+	printAttacks(char)
+	printInventory(char)
 
 	// Print calculated stats
+	printHitPoints(char)
+	fmt.Printf("Hit dice: %d/%s\n", char.HitDice.Remaining(), char.HitDice)
 	fmt.Printf("Armor class: %d\n", char.ArmorClass())
-	fmt.Printf("Initiative bonus: %d\n", char.Initiative())
+	if mode := char.InitiativeMode().String(); mode != "" {
+		fmt.Printf("Initiative bonus: %d (%s)\n", char.Initiative(), mode)
+	} else {
+		fmt.Printf("Initiative bonus: %d\n", char.Initiative())
+	}
+	fmt.Printf("Speed: %d ft\n", char.Speed())
 	fmt.Printf("Passive perception: %d\n", char.PassivePerception())
+	printConditions(char)
 }
 
 // max returns the maximum of two integers
@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// formatCombatError turns domain attack and consumable errors into messages for the user
+func formatCombatError(name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCharacterDead):
+		return fmt.Errorf("%s is dead", name)
+	case errors.Is(err, domain.ErrIncapacitated):
+		return fmt.Errorf("%s is incapacitated and can't act", name)
+	case errors.Is(err, domain.ErrOutOfAmmunition):
+		return fmt.Errorf("%s is %v", name, err)
+	case errors.Is(err, domain.ErrSlotEmpty):
+		return fmt.Errorf("%s has no weapon in that hand", name)
+	case errors.Is(err, domain.ErrNoPowerAttack):
+		return fmt.Errorf("%s can't power attack with that weapon: it needs Great Weapon Master and a heavy melee weapon, or Sharpshooter and a ranged weapon", name)
+	}
+	return err
+}
+
+// AttackCommand handles rolling a weapon attack
+type AttackCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name        *string
+	offHand     *bool
+	powerAttack *bool
+}
+
+// NewAttackCommand creates a new attack command
+func NewAttackCommand(characterService *service.CharacterService) *AttackCommand {
+	cmd := &AttackCommand{
+		BaseCommand:      NewBaseCommand("attack"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.offHand = cmd.flagSet.Bool("offhand", false, "attack with the off-hand weapon")
+	cmd.powerAttack = cmd.flagSet.Bool("power", false, "take -5 to hit for +10 damage (Great Weapon Master or Sharpshooter)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *AttackCommand) Name() string {
+	return "attack"
+}
+
+// Execute rolls the attack
+func (c *AttackCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	result, err := c.characterService.Attack(*c.name, *c.offHand, *c.powerAttack)
+	if err != nil {
+		return formatCombatError(*c.name, err)
+	}
+
+	roll := fmt.Sprintf("d20 %d", result.D20)
+	if mode := result.Mode.String(); mode != "" {
+		roll += " with " + mode
+	}
+	fmt.Printf("%s: %d to hit (%s, %+d)\n", result.Attack.Name, result.Total, roll, result.Attack.AttackBonus)
+	if result.PowerAttack != "" {
+		fmt.Printf("%s: -5 to hit, +10 damage\n", result.PowerAttack)
+	}
+	switch {
+	case result.Fumble:
+		fmt.Println("Natural 1: the attack misses")
+	case result.Attack.Damage == "":
+		fmt.Println("No damage")
+	default:
+		if result.Critical {
+			fmt.Println("Critical hit!")
+		}
+		fmt.Printf("Damage: %d %s\n", result.Damage, strings.ToLower(result.Attack.DamageType))
+	}
+	if result.Ammunition != "" {
+		fmt.Printf("%s left: %d\n", result.Ammunition, result.AmmunitionLeft)
+	}
+
+	return nil
+}
+
+// Usage prints attack command usage
+func (c *AttackCommand) Usage() {
+	fmt.Println("  attack -name CHARACTER_NAME [-offhand] [-power]")
+}
+
+// RecoverAmmoCommand handles picking up spent ammunition after a fight
+type RecoverAmmoCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+}
+
+// NewRecoverAmmoCommand creates a new recover-ammo command
+func NewRecoverAmmoCommand(characterService *service.CharacterService) *RecoverAmmoCommand {
+	cmd := &RecoverAmmoCommand{
+		BaseCommand:      NewBaseCommand("recover-ammo"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *RecoverAmmoCommand) Name() string {
+	return "recover-ammo"
+}
+
+// Execute recovers the ammunition
+func (c *RecoverAmmoCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	recovered, err := c.characterService.RecoverAmmunition(*c.name)
+	if err != nil {
+		return formatInventoryError(*c.name, "ammunition", err)
+	}
+
+	if len(recovered) == 0 {
+		fmt.Printf("%s has no ammunition to recover\n", *c.name)
+		return nil
+	}
+	for _, item := range recovered {
+		fmt.Printf("Recovered %s\n", item)
+	}
+
+	return nil
+}
+
+// Usage prints recover-ammo command usage
+func (c *RecoverAmmoCommand) Usage() {
+	fmt.Println("  recover-ammo -name CHARACTER_NAME")
+}
+
+// UseItemCommand handles drinking a potion or reading a scroll
+type UseItemCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+	item *string
+}
+
+// NewUseItemCommand creates a new use-item command
+func NewUseItemCommand(characterService *service.CharacterService) *UseItemCommand {
+	cmd := &UseItemCommand{
+		BaseCommand:      NewBaseCommand("use-item"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.item = cmd.flagSet.String("item", "", "potion or scroll to use (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *UseItemCommand) Name() string {
+	return "use-item"
+}
+
+// Execute uses up the item
+func (c *UseItemCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if *c.item == "" {
+		return fmt.Errorf("item is required")
+	}
+
+	result, err := c.characterService.UseItem(*c.name, *c.item)
+	if err != nil {
+		return formatCombatError(*c.name, formatInventoryError(*c.name, *c.item, err))
+	}
+
+	fmt.Printf("Used %s\n", result.Item)
+	if result.Spell != "" {
+		fmt.Printf("Cast %s from the scroll\n", result.Spell)
+	}
+	if result.Rolled > 0 {
+		fmt.Printf("Healed %d hit points (rolled %d)\n", result.Healed, result.Rolled)
+		character, err := c.characterService.GetCharacter(*c.name)
+		if err != nil {
+			return err
+		}
+		printHitPoints(character)
+	}
+	fmt.Printf("%s left: %d\n", result.Item, result.Remaining)
+
+	return nil
+}
+
+// Usage prints use-item command usage
+func (c *UseItemCommand) Usage() {
+	fmt.Println("  use-item -name CHARACTER_NAME -item ITEM")
+}
@@ -3,8 +3,10 @@ package cli
 import (
 	"DnD-sheet/internal/character/domain"
 	"DnD-sheet/internal/character/service"
+	spelldomain "DnD-sheet/internal/spell/domain"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // CreateCommand handles character creation
@@ -220,6 +222,7 @@ type UpdateCommand struct {
 
 	name  *string
 	level *int
+	class *string
 }
 
 // NewUpdateCommand creates a new update command
@@ -231,6 +234,7 @@ func NewUpdateCommand(characterService *service.CharacterService) *UpdateCommand
 
 	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
 	cmd.level = cmd.flagSet.Int("level", 0, "new level")
+	cmd.class = cmd.flagSet.String("class", "", "class to level up, adding it as a multiclass if needed (level is then the level in that class)")
 	return cmd
 }
 
@@ -245,7 +249,19 @@ func (c *UpdateCommand) Execute() error {
 		return fmt.Errorf("name and level (>=1) are required")
 	}
 
-	if err := c.characterService.UpdateLevel(*c.name, *c.level); err != nil {
+	var result domain.LevelUpResult
+	var err error
+	if *c.class != "" {
+		result, err = c.characterService.UpdateClassLevel(*c.name, *c.class, *c.level)
+	} else {
+		result, err = c.characterService.UpdateLevel(*c.name, *c.level)
+	}
+	switch {
+	case errors.Is(err, domain.ErrInvalidLevel):
+		return fmt.Errorf("total character level must be between 1 and %d", domain.MaxCharacterLevel)
+	case errors.Is(err, domain.ErrMulticlassPrerequisites):
+		return fmt.Errorf("%v: multiclassing needs 13 in the primary ability of every class", err)
+	case err != nil:
 		return err
 	}
 
@@ -255,12 +271,28 @@ func (c *UpdateCommand) Execute() error {
 	}
 
 	fmt.Printf("Updated %s to level %d (Proficiency Bonus: %d)\n", character.Name, character.Level, character.ProficiencyBonus)
+	if character.IsMulticlassed() {
+		fmt.Printf("Classes: %s\n", character.ClassSummary())
+	}
+	for _, feature := range result.Features {
+		fmt.Printf("New feature: %s - %s\n", feature, feature.Description)
+	}
+	if result.NeedsSubclass {
+		class := *c.class
+		if class == "" {
+			class = character.Class
+		}
+		fmt.Printf("Choose a %s subclass with the subclass command (%s)\n", strings.ToLower(class), strings.Join(domain.Subclasses(class), ", "))
+	}
+	if character.PendingASI > 0 {
+		fmt.Printf("%d ability score improvement(s) pending, use the asi command to choose\n", character.PendingASI)
+	}
 	return nil
 }
 
 // Usage prints update command usage
 func (c *UpdateCommand) Usage() {
-	fmt.Println("  update -name CHARACTER_NAME -level N")
+	fmt.Println("  update -name CHARACTER_NAME -level N [-class CLASS]")
 }
 
 // EquipCommand handles character equipment
@@ -268,11 +300,13 @@ type EquipCommand struct {
 	*BaseCommand
 	characterService *service.CharacterService
 
-	name   *string
-	weapon *string
-	armor  *string
-	shield *string
-	slot   *string
+	name       *string
+	weapon     *string
+	armor      *string
+	shield     *string
+	slot       *string
+	strict     *bool
+	improvised *bool
 }
 
 // NewEquipCommand creates a new equip command
@@ -286,7 +320,9 @@ func NewEquipCommand(characterService *service.CharacterService) *EquipCommand {
 	cmd.weapon = cmd.flagSet.String("weapon", "", "weapon name")
 	cmd.armor = cmd.flagSet.String("armor", "", "armor name")
 	cmd.shield = cmd.flagSet.String("shield", "", "shield name")
-	cmd.slot = cmd.flagSet.String("slot", "", "equipment slot")
+	cmd.slot = cmd.flagSet.String("slot", "", "weapon slot: main hand (default) or off hand")
+	cmd.strict = cmd.flagSet.Bool("strict", false, "refuse equipment the character isn't proficient with")
+	cmd.improvised = cmd.flagSet.Bool("improvised", false, "wield an object that isn't a catalog weapon as an improvised weapon")
 	return cmd
 }
 
@@ -301,8 +337,9 @@ func (c *EquipCommand) Execute() error {
 		return fmt.Errorf("name is required")
 	}
 
-	if err := c.characterService.EquipCharacter(*c.name, *c.weapon, *c.armor, *c.shield, *c.slot); err != nil {
-		return err
+	warnings, err := c.characterService.EquipCharacter(*c.name, *c.weapon, *c.armor, *c.shield, *c.slot, *c.strict, *c.improvised)
+	if err != nil {
+		return formatEquipmentError(*c.name, err)
 	}
 
 	// Print equipment messages like the original
@@ -319,13 +356,16 @@ func (c *EquipCommand) Execute() error {
 	if *c.shield != "" {
 		fmt.Printf("Equipped shield %s\n", *c.shield)
 	}
+	for _, warning := range warnings {
+		fmt.Printf("Warning: %s\n", warning)
+	}
 
 	return nil
 }
 
 // Usage prints equip command usage
 func (c *EquipCommand) Usage() {
-	fmt.Println("  equip -name CHARACTER_NAME -weapon WEAPON_NAME -armor ARMOR_NAME -shield SHIELD_NAME")
+	fmt.Println("  equip -name CHARACTER_NAME -weapon WEAPON_NAME [-slot main hand|off hand] -armor ARMOR_NAME -shield SHIELD_NAME [-strict] [-improvised]")
 }
 
 // PrepareSpellCommand handles spell preparation
@@ -334,8 +374,9 @@ type PrepareSpellCommand struct {
 	characterService *service.CharacterService
 
 	// Flags
-	name  *string
-	spell *string
+	name     *string
+	spell    *string
+	override *bool
 }
 
 // NewPrepareSpellCommand creates a new prepare-spell command
@@ -348,6 +389,7 @@ func NewPrepareSpellCommand(characterService *service.CharacterService) *Prepare
 	// Define flags
 	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
 	cmd.spell = cmd.flagSet.String("spell", "", "spell name (required)")
+	cmd.override = cmd.flagSet.Bool("override", false, "allow a spell from outside the class spell list, e.g. from Magical Secrets or domain spells")
 
 	return cmd
 }
@@ -363,9 +405,9 @@ func (c *PrepareSpellCommand) Execute() error {
 		return fmt.Errorf("name and spell are required")
 	}
 
-	err := c.characterService.PrepareSpell(*c.name, *c.spell)
+	err := c.characterService.PrepareSpell(*c.name, *c.spell, *c.override)
 	if err != nil {
-		return err
+		return formatSpellListError(err)
 	}
 
 	fmt.Printf("Prepared spell %s\n", *c.spell)
@@ -374,7 +416,21 @@ func (c *PrepareSpellCommand) Execute() error {
 
 // Usage prints prepare-spell command usage
 func (c *PrepareSpellCommand) Usage() {
-	fmt.Println("  prepare-spell -name CHARACTER_NAME -spell SPELL_NAME")
+	fmt.Println("  prepare-spell -name CHARACTER_NAME -spell SPELL_NAME [-override]")
+}
+
+// formatSpellListError adds a hint about the override flag to spell list errors, and about making room to spell
+// limit errors
+func formatSpellListError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrSpellNotOnClassList):
+		return fmt.Errorf("%w, use -override for spells granted by features such as Magical Secrets", err)
+	case errors.Is(err, domain.ErrTooManySpellsKnown):
+		return fmt.Errorf("%w, use swap-spell or forget-spell to replace a known spell", err)
+	case errors.Is(err, domain.ErrTooManySpellsPrepared):
+		return fmt.Errorf("%w, use unprepare-spell to make room", err)
+	}
+	return err
 }
 
 // LearnSpellCommand handles spell learning
@@ -383,8 +439,9 @@ type LearnSpellCommand struct {
 	characterService *service.CharacterService
 
 	// Flags
-	name  *string
-	spell *string
+	name     *string
+	spell    *string
+	override *bool
 }
 
 // NewLearnSpellCommand creates a new learn-spell command
@@ -397,6 +454,7 @@ func NewLearnSpellCommand(characterService *service.CharacterService) *LearnSpel
 	// Define flags
 	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
 	cmd.spell = cmd.flagSet.String("spell", "", "spell name (required)")
+	cmd.override = cmd.flagSet.Bool("override", false, "allow a spell from outside the class spell list, e.g. from Magical Secrets or domain spells")
 
 	return cmd
 }
@@ -412,9 +470,9 @@ func (c *LearnSpellCommand) Execute() error {
 		return fmt.Errorf("name and spell are required")
 	}
 
-	err := c.characterService.LearnSpell(*c.name, *c.spell)
+	err := c.characterService.LearnSpell(*c.name, *c.spell, *c.override)
 	if err != nil {
-		return err
+		return formatSpellListError(err)
 	}
 
 	fmt.Printf("Learned spell %s\n", *c.spell)
@@ -423,7 +481,7 @@ func (c *LearnSpellCommand) Execute() error {
 
 // Usage prints learn-spell command usage
 func (c *LearnSpellCommand) Usage() {
-	fmt.Println("  learn-spell -name CHARACTER_NAME -spell SPELL_NAME")
+	fmt.Println("  learn-spell -name CHARACTER_NAME -spell SPELL_NAME [-override]")
 }
 
 // CastSpellCommand handles spell casting
@@ -434,6 +492,7 @@ type CastSpellCommand struct {
 	// Flags
 	name  *string
 	spell *string
+	slot  *int
 }
 
 // NewCastSpellCommand creates a new cast-spell command
@@ -446,6 +505,7 @@ func NewCastSpellCommand(characterService *service.CharacterService) *CastSpellC
 	// Define flags
 	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
 	cmd.spell = cmd.flagSet.String("spell", "", "spell name (required)")
+	cmd.slot = cmd.flagSet.Int("slot", 0, "spell slot level to cast with (default: lowest available slot)")
 
 	return cmd
 }
@@ -461,15 +521,43 @@ func (c *CastSpellCommand) Execute() error {
 		return fmt.Errorf("name and spell are required")
 	}
 
-	err := c.characterService.CastSpell(*c.name, *c.spell)
+	result, err := c.characterService.CastSpell(*c.name, *c.spell, *c.slot)
+	spell := result.Spell
 	if err != nil {
 		// Format domain errors into user-friendly CLI messages
 		if errors.Is(err, domain.ErrNoSpellSlot) {
+			if *c.slot != 0 {
+				return fmt.Errorf("No level %d spell slot available!", *c.slot)
+			}
 			return fmt.Errorf("No spell slot available!")
 		}
+		if errors.Is(err, domain.ErrSlotTooLow) {
+			return fmt.Errorf("%s needs a spell slot of level %d or higher", spell.Name, spell.Level)
+		}
+		if errors.Is(err, domain.ErrArmorPreventsSpellcasting) {
+			return fmt.Errorf("%s can't cast spells while wearing armor without proficiency", *c.name)
+		}
 		return err
 	}
 
+	if spell.IsCantrip() {
+		fmt.Printf("Cast %s (cantrip)\n", spell.Name)
+	} else {
+		fmt.Printf("Cast %s with a %s level slot\n", spell.Name, spelldomain.Ordinal(result.SlotLevel))
+	}
+	if result.SlotLevel > spell.Level && spell.HigherLevel != "" {
+		fmt.Printf("At higher levels: %s\n", spell.HigherLevel)
+		if result.UpcastEffect != "" {
+			fmt.Printf("Cast at %s level: %s\n", spelldomain.Ordinal(result.SlotLevel), result.UpcastEffect)
+		}
+	}
+	if result.EndedConcentration != "" {
+		fmt.Printf("Warning: %s stops concentrating on %s\n", *c.name, result.EndedConcentration)
+	}
+	if spell.Concentration {
+		fmt.Printf("%s is concentrating on %s\n", *c.name, spell.Name)
+	}
+
 	// Load character to display updated spell slots
 	character, err := c.characterService.GetCharacter(*c.name)
 	if err != nil {
@@ -484,5 +572,5 @@ func (c *CastSpellCommand) Execute() error {
 
 // Usage prints cast-spell command usage
 func (c *CastSpellCommand) Usage() {
-	fmt.Println("  cast-spell -name CHARACTER_NAME -spell SPELL_NAME")
+	fmt.Println("  cast-spell -name CHARACTER_NAME -spell SPELL_NAME [-slot LEVEL]")
 }
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// formatConditionError turns domain condition errors into messages for the user
+func formatConditionError(name, condition string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownCondition):
+		return fmt.Errorf("unknown condition, choose one of: %s", strings.Join(domain.ConditionNames(), ", "))
+	case errors.Is(err, domain.ErrConditionAlreadyActive):
+		return fmt.Errorf("%s is already %s", name, condition)
+	case errors.Is(err, domain.ErrConditionNotActive):
+		return fmt.Errorf("%s doesn't have the %s condition", name, condition)
+	case errors.Is(err, domain.ErrInvalidExhaustionLevel):
+		return fmt.Errorf("levels must be at least 1")
+	}
+	return formatHitPointError(name, err)
+}
+
+// AddConditionCommand handles applying a condition or exhaustion
+type AddConditionCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name      *string
+	condition *string
+	levels    *int
+}
+
+// NewAddConditionCommand creates a new add-condition command
+func NewAddConditionCommand(characterService *service.CharacterService) *AddConditionCommand {
+	cmd := &AddConditionCommand{
+		BaseCommand:      NewBaseCommand("add-condition"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.condition = cmd.flagSet.String("condition", "", "condition, e.g. poisoned or exhaustion (required)")
+	cmd.levels = cmd.flagSet.Int("levels", 1, "exhaustion levels to add")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *AddConditionCommand) Name() string {
+	return "add-condition"
+}
+
+// Execute applies the condition
+func (c *AddConditionCommand) Execute() error {
+	if *c.name == "" || *c.condition == "" {
+		return fmt.Errorf("name and condition are required")
+	}
+
+	if err := c.characterService.AddCondition(*c.name, *c.condition, *c.levels); err != nil {
+		return formatConditionError(*c.name, *c.condition, err)
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+
+	if character.Dead {
+		fmt.Printf("%s dies of exhaustion\n", character.Name)
+		return nil
+	}
+	if strings.EqualFold(*c.condition, domain.ConditionExhaustion) {
+		fmt.Printf("%s's exhaustion is now level %d\n", character.Name, character.Exhaustion)
+	} else {
+		fmt.Printf("%s is now %s\n", character.Name, strings.ToLower(*c.condition))
+	}
+	printConditions(character)
+	printHitPoints(character)
+	fmt.Printf("Speed: %d ft\n", character.Speed())
+
+	return nil
+}
+
+// Usage prints add-condition command usage
+func (c *AddConditionCommand) Usage() {
+	fmt.Println("  add-condition -name CHARACTER_NAME -condition CONDITION [-levels N]")
+}
+
+// RemoveConditionCommand handles ending a condition or lowering exhaustion
+type RemoveConditionCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name      *string
+	condition *string
+	levels    *int
+}
+
+// NewRemoveConditionCommand creates a new remove-condition command
+func NewRemoveConditionCommand(characterService *service.CharacterService) *RemoveConditionCommand {
+	cmd := &RemoveConditionCommand{
+		BaseCommand:      NewBaseCommand("remove-condition"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.condition = cmd.flagSet.String("condition", "", "condition, e.g. poisoned or exhaustion (required)")
+	cmd.levels = cmd.flagSet.Int("levels", 1, "exhaustion levels to remove")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *RemoveConditionCommand) Name() string {
+	return "remove-condition"
+}
+
+// Execute ends the condition
+func (c *RemoveConditionCommand) Execute() error {
+	if *c.name == "" || *c.condition == "" {
+		return fmt.Errorf("name and condition are required")
+	}
+
+	if err := c.characterService.RemoveCondition(*c.name, *c.condition, *c.levels); err != nil {
+		return formatConditionError(*c.name, *c.condition, err)
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(*c.condition, domain.ConditionExhaustion) {
+		fmt.Printf("%s's exhaustion is now level %d\n", character.Name, character.Exhaustion)
+	} else {
+		fmt.Printf("%s is no longer %s\n", character.Name, strings.ToLower(*c.condition))
+	}
+	printConditions(character)
+	printHitPoints(character)
+	fmt.Printf("Speed: %d ft\n", character.Speed())
+
+	return nil
+}
+
+// Usage prints remove-condition command usage
+func (c *RemoveConditionCommand) Usage() {
+	fmt.Println("  remove-condition -name CHARACTER_NAME -condition CONDITION [-levels N]")
+}
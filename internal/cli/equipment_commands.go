@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+)
+
+// formatEquipmentError turns domain equipment slot errors into messages for the user
+// "already occupied" errors keep their message, as scripts rely on it
+func formatEquipmentError(name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidSlot):
+		return fmt.Errorf("invalid slot, use main hand, off hand, armor or shield")
+	case errors.Is(err, domain.ErrSlotEmpty):
+		return fmt.Errorf("%s has nothing equipped there", name)
+	case errors.Is(err, domain.ErrHandsFull):
+		return fmt.Errorf("%s can't do that: a two-handed weapon needs both hands", name)
+	case errors.Is(err, domain.ErrNotLightWeapon):
+		return fmt.Errorf("%s can only fight with two weapons if both are light", name)
+	case errors.Is(err, domain.ErrUnknownWeapon):
+		return fmt.Errorf("%v (use -improvised to wield another object as an improvised weapon)", err)
+	}
+	return err
+}
+
+// UnequipCommand handles taking off equipment
+type UnequipCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+	slot *string
+}
+
+// NewUnequipCommand creates a new unequip command
+func NewUnequipCommand(characterService *service.CharacterService) *UnequipCommand {
+	cmd := &UnequipCommand{
+		BaseCommand:      NewBaseCommand("unequip"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.slot = cmd.flagSet.String("slot", "main hand", "slot to empty: main hand, off hand, armor or shield")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *UnequipCommand) Name() string {
+	return "unequip"
+}
+
+// Execute empties the slot
+func (c *UnequipCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	item, err := c.characterService.UnequipCharacter(*c.name, *c.slot)
+	if err != nil {
+		return formatEquipmentError(*c.name, err)
+	}
+
+	fmt.Printf("Unequipped %s from %s\n", item, *c.slot)
+
+	return nil
+}
+
+// Usage prints unequip command usage
+func (c *UnequipCommand) Usage() {
+	fmt.Println("  unequip -name CHARACTER_NAME [-slot main hand|off hand|armor|shield]")
+}
+
+// SwapCommand handles switching the weapons between the main hand and off hand
+type SwapCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+}
+
+// NewSwapCommand creates a new swap command
+func NewSwapCommand(characterService *service.CharacterService) *SwapCommand {
+	cmd := &SwapCommand{
+		BaseCommand:      NewBaseCommand("swap"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *SwapCommand) Name() string {
+	return "swap"
+}
+
+// Execute swaps the weapons
+func (c *SwapCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	char, err := c.characterService.SwapWeapons(*c.name)
+	if err != nil {
+		return formatEquipmentError(*c.name, err)
+	}
+
+	fmt.Printf("Main hand: %s\n", orNothing(char.Weapon))
+	fmt.Printf("Off hand: %s\n", orNothing(char.OffHand))
+
+	return nil
+}
+
+// Usage prints swap command usage
+func (c *SwapCommand) Usage() {
+	fmt.Println("  swap -name CHARACTER_NAME")
+}
+
+// orNothing returns the item name, or "nothing" for an empty slot
+func orNothing(item string) string {
+	if item == "" {
+		return "nothing"
+	}
+	return item
+}
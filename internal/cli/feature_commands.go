@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SubclassCommand handles choosing a subclass
+type SubclassCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	class    *string
+	subclass *string
+}
+
+// NewSubclassCommand creates a new subclass command
+func NewSubclassCommand(characterService *service.CharacterService) *SubclassCommand {
+	cmd := &SubclassCommand{
+		BaseCommand:      NewBaseCommand("subclass"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.class = cmd.flagSet.String("class", "", "class to choose the subclass for (defaults to the primary class)")
+	cmd.subclass = cmd.flagSet.String("subclass", "", "subclass name, e.g. champion (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *SubclassCommand) Name() string {
+	return "subclass"
+}
+
+// Execute chooses the subclass
+func (c *SubclassCommand) Execute() error {
+	if *c.name == "" || *c.subclass == "" {
+		return fmt.Errorf("name and subclass are required")
+	}
+
+	features, err := c.characterService.ChooseSubclass(*c.name, *c.class, *c.subclass)
+	switch {
+	case errors.Is(err, domain.ErrUnknownSubclass):
+		character, loadErr := c.characterService.GetCharacter(*c.name)
+		if loadErr != nil {
+			return loadErr
+		}
+		class := *c.class
+		if class == "" {
+			class = character.Class
+		}
+		return fmt.Errorf("unknown subclass, choose one of: %s", strings.Join(domain.Subclasses(class), ", "))
+	case errors.Is(err, domain.ErrSubclassLevelTooLow):
+		return fmt.Errorf("%s can't choose a subclass yet", *c.name)
+	case errors.Is(err, domain.ErrClassNotTaken):
+		return fmt.Errorf("%s has no levels in %s", *c.name, *c.class)
+	case err != nil:
+		return err
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is now %s\n", character.Name, character.ClassSummary())
+	for _, feature := range features {
+		fmt.Printf("New feature: %s - %s\n", feature, feature.Description)
+	}
+
+	return nil
+}
+
+// Usage prints subclass command usage
+func (c *SubclassCommand) Usage() {
+	fmt.Println("  subclass -name CHARACTER_NAME -subclass SUBCLASS [-class CLASS]")
+}
+
+// FightingStyleCommand handles choosing a Fighting Style
+type FightingStyleCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name  *string
+	style *string
+}
+
+// NewFightingStyleCommand creates a new fighting-style command
+func NewFightingStyleCommand(characterService *service.CharacterService) *FightingStyleCommand {
+	cmd := &FightingStyleCommand{
+		BaseCommand:      NewBaseCommand("fighting-style"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.style = cmd.flagSet.String("style", "", "fighting style, e.g. defense (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *FightingStyleCommand) Name() string {
+	return "fighting-style"
+}
+
+// Execute chooses the Fighting Style
+func (c *FightingStyleCommand) Execute() error {
+	if *c.name == "" || *c.style == "" {
+		return fmt.Errorf("name and style are required")
+	}
+
+	err := c.characterService.ChooseFightingStyle(*c.name, *c.style)
+	switch {
+	case errors.Is(err, domain.ErrUnknownFightingStyle):
+		return fmt.Errorf("unknown fighting style, choose one of: %s", strings.Join(domain.FightingStyleNames(), ", "))
+	case errors.Is(err, domain.ErrNoFightingStyleFeature):
+		return fmt.Errorf("%s doesn't have the Fighting Style feature", *c.name)
+	case err != nil:
+		return err
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s uses the %s fighting style\n", character.Name, character.FightingStyle)
+	fmt.Printf("Armor class: %d\n", character.ArmorClass())
+
+	return nil
+}
+
+// Usage prints fighting-style command usage
+func (c *FightingStyleCommand) Usage() {
+	fmt.Println("  fighting-style -name CHARACTER_NAME -style STYLE")
+}
@@ -0,0 +1,335 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+)
+
+// formatHitPointError converts domain hit point errors into user-friendly CLI messages
+func formatHitPointError(name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCharacterDead):
+		return fmt.Errorf("%s is dead", name)
+	case errors.Is(err, domain.ErrInvalidHitPointAmount):
+		return fmt.Errorf("amount must not be negative")
+	case errors.Is(err, domain.ErrNotDying):
+		return fmt.Errorf("%s is not dying", name)
+	case errors.Is(err, domain.ErrInvalidDeathSaveRoll):
+		return fmt.Errorf("roll must be between 1 and 20")
+	}
+	return err
+}
+
+// DamageCommand handles applying damage to a character
+type DamageCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	amount *int
+}
+
+// NewDamageCommand creates a new damage command
+func NewDamageCommand(characterService *service.CharacterService) *DamageCommand {
+	cmd := &DamageCommand{
+		BaseCommand:      NewBaseCommand("damage"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.amount = cmd.flagSet.Int("amount", 0, "amount of damage (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *DamageCommand) Name() string {
+	return "damage"
+}
+
+// Execute applies damage to the character
+func (c *DamageCommand) Execute() error {
+	if *c.name == "" || *c.amount <= 0 {
+		return fmt.Errorf("name and amount (>=1) are required")
+	}
+
+	result, err := c.characterService.DamageCharacter(*c.name, *c.amount)
+	if err != nil {
+		return formatHitPointError(*c.name, err)
+	}
+
+	if result.Absorbed > 0 {
+		fmt.Printf("Temporary hit points absorbed %d damage\n", result.Absorbed)
+	}
+	fmt.Printf("%s takes %d damage\n", *c.name, result.Taken)
+	if result.InstantDeath {
+		fmt.Println("Massive damage! The character dies instantly.")
+	}
+	if result.FailedSave {
+		fmt.Println("Damage at 0 hit points counts as a failed death save")
+	}
+	if result.LostConcentration != "" {
+		fmt.Printf("%s loses concentration on %s\n", *c.name, result.LostConcentration)
+	}
+	if result.ConcentrationDC > 0 {
+		fmt.Printf("Concentration check needed (DC %d): concentration-check -name %s -damage %d\n", result.ConcentrationDC, *c.name, *c.amount)
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+
+	return nil
+}
+
+// Usage prints damage command usage
+func (c *DamageCommand) Usage() {
+	fmt.Println("  damage -name CHARACTER_NAME -amount N")
+}
+
+// HealCommand handles healing a character
+type HealCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	amount *int
+}
+
+// NewHealCommand creates a new heal command
+func NewHealCommand(characterService *service.CharacterService) *HealCommand {
+	cmd := &HealCommand{
+		BaseCommand:      NewBaseCommand("heal"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.amount = cmd.flagSet.Int("amount", 0, "hit points to restore (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *HealCommand) Name() string {
+	return "heal"
+}
+
+// Execute heals the character
+func (c *HealCommand) Execute() error {
+	if *c.name == "" || *c.amount <= 0 {
+		return fmt.Errorf("name and amount (>=1) are required")
+	}
+
+	healed, err := c.characterService.HealCharacter(*c.name, *c.amount)
+	if err != nil {
+		return formatHitPointError(*c.name, err)
+	}
+
+	fmt.Printf("%s regains %d hit points\n", *c.name, healed)
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+
+	return nil
+}
+
+// Usage prints heal command usage
+func (c *HealCommand) Usage() {
+	fmt.Println("  heal -name CHARACTER_NAME -amount N")
+}
+
+// TempHPCommand handles granting temporary hit points
+type TempHPCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	amount *int
+}
+
+// NewTempHPCommand creates a new temp-hp command
+func NewTempHPCommand(characterService *service.CharacterService) *TempHPCommand {
+	cmd := &TempHPCommand{
+		BaseCommand:      NewBaseCommand("temp-hp"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.amount = cmd.flagSet.Int("amount", 0, "temporary hit points (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *TempHPCommand) Name() string {
+	return "temp-hp"
+}
+
+// Execute grants temporary hit points to the character
+func (c *TempHPCommand) Execute() error {
+	if *c.name == "" || *c.amount <= 0 {
+		return fmt.Errorf("name and amount (>=1) are required")
+	}
+
+	replaced, err := c.characterService.GrantTemporaryHitPoints(*c.name, *c.amount)
+	if err != nil {
+		return formatHitPointError(*c.name, err)
+	}
+
+	if !replaced {
+		// D&D 5e rule: temporary hit points don't stack
+		fmt.Println("Temporary hit points don't stack; keeping the current higher value")
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+
+	return nil
+}
+
+// Usage prints temp-hp command usage
+func (c *TempHPCommand) Usage() {
+	fmt.Println("  temp-hp -name CHARACTER_NAME -amount N")
+}
+
+// MaxHPCommand handles adjustments to the hit point maximum
+type MaxHPCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	adjust *int
+}
+
+// NewMaxHPCommand creates a new max-hp command
+func NewMaxHPCommand(characterService *service.CharacterService) *MaxHPCommand {
+	cmd := &MaxHPCommand{
+		BaseCommand:      NewBaseCommand("max-hp"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.adjust = cmd.flagSet.Int("adjust", 0, "change to the hit point maximum, may be negative (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *MaxHPCommand) Name() string {
+	return "max-hp"
+}
+
+// Execute adjusts the character's hit point maximum
+func (c *MaxHPCommand) Execute() error {
+	if *c.name == "" || *c.adjust == 0 {
+		return fmt.Errorf("name and a non-zero adjust are required")
+	}
+
+	if err := c.characterService.AdjustMaxHitPoints(*c.name, *c.adjust); err != nil {
+		return err
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+
+	return nil
+}
+
+// Usage prints max-hp command usage
+func (c *MaxHPCommand) Usage() {
+	fmt.Println("  max-hp -name CHARACTER_NAME -adjust N")
+}
+
+// DeathSaveCommand handles death saving throws and stabilising a dying character
+type DeathSaveCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name      *string
+	roll      *int
+	stabilize *bool
+}
+
+// NewDeathSaveCommand creates a new death-save command
+func NewDeathSaveCommand(characterService *service.CharacterService) *DeathSaveCommand {
+	cmd := &DeathSaveCommand{
+		BaseCommand:      NewBaseCommand("death-save"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.roll = cmd.flagSet.Int("roll", 0, "d20 result (rolled automatically if omitted)")
+	cmd.stabilize = cmd.flagSet.Bool("stabilize", false, "stabilize the character instead of rolling")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *DeathSaveCommand) Name() string {
+	return "death-save"
+}
+
+// Execute rolls a death save or stabilizes the character
+func (c *DeathSaveCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if *c.stabilize {
+		if err := c.characterService.StabilizeCharacter(*c.name); err != nil {
+			return formatHitPointError(*c.name, err)
+		}
+		fmt.Printf("%s is stable\n", *c.name)
+		return nil
+	}
+
+	result, err := c.characterService.RollDeathSave(*c.name, *c.roll)
+	if err != nil {
+		return formatHitPointError(*c.name, err)
+	}
+
+	outcome := "failure"
+	if result.Success {
+		outcome = "success"
+	}
+	if result.Critical {
+		outcome = "critical " + outcome
+	}
+	fmt.Printf("Rolled %d: %s\n", result.Roll, outcome)
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+
+	return nil
+}
+
+// Usage prints death-save command usage
+func (c *DeathSaveCommand) Usage() {
+	fmt.Println("  death-save -name CHARACTER_NAME [-roll N] [-stabilize]")
+}
@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// formatInventoryError turns domain inventory and currency errors into messages for the user
+func formatInventoryError(name, item string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownItem):
+		return fmt.Errorf("%s is not in the equipment catalog", item)
+	case errors.Is(err, domain.ErrItemNotCarried):
+		return fmt.Errorf("%s doesn't carry %s", name, item)
+	case errors.Is(err, domain.ErrInvalidItemQuantity):
+		return fmt.Errorf("invalid quantity")
+	case errors.Is(err, domain.ErrInvalidCoins):
+		return fmt.Errorf("invalid amount, use coins like \"15 gp\" or \"3gp 5sp\"")
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		return fmt.Errorf("%s can't afford that", name)
+	}
+	return err
+}
+
+// AddItemCommand handles putting items into a character's inventory
+type AddItemCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	item     *string
+	quantity *int
+	notes    *string
+}
+
+// NewAddItemCommand creates a new add-item command
+func NewAddItemCommand(characterService *service.CharacterService) *AddItemCommand {
+	cmd := &AddItemCommand{
+		BaseCommand:      NewBaseCommand("add-item"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.item = cmd.flagSet.String("item", "", "item name from the equipment catalog (required)")
+	cmd.quantity = cmd.flagSet.Int("quantity", 1, "number of items")
+	cmd.notes = cmd.flagSet.String("notes", "", "notes about the item")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *AddItemCommand) Name() string {
+	return "add-item"
+}
+
+// Execute adds the item
+func (c *AddItemCommand) Execute() error {
+	if *c.name == "" || *c.item == "" {
+		return fmt.Errorf("name and item are required")
+	}
+
+	item, err := c.characterService.AddItem(*c.name, *c.item, *c.quantity, *c.notes)
+	if err != nil {
+		return formatInventoryError(*c.name, *c.item, err)
+	}
+
+	fmt.Printf("Added %d %s to %s's inventory\n", *c.quantity, item.Name, *c.name)
+	fmt.Printf("Now carrying: %s\n", item)
+
+	return nil
+}
+
+// Usage prints add-item command usage
+func (c *AddItemCommand) Usage() {
+	fmt.Println("  add-item -name CHARACTER_NAME -item ITEM [-quantity N] [-notes NOTES]")
+}
+
+// RemoveItemCommand handles taking items out of a character's inventory
+type RemoveItemCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	item     *string
+	quantity *int
+}
+
+// NewRemoveItemCommand creates a new remove-item command
+func NewRemoveItemCommand(characterService *service.CharacterService) *RemoveItemCommand {
+	cmd := &RemoveItemCommand{
+		BaseCommand:      NewBaseCommand("remove-item"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.item = cmd.flagSet.String("item", "", "item name (required)")
+	cmd.quantity = cmd.flagSet.Int("quantity", 1, "number of items")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *RemoveItemCommand) Name() string {
+	return "remove-item"
+}
+
+// Execute removes the item
+func (c *RemoveItemCommand) Execute() error {
+	if *c.name == "" || *c.item == "" {
+		return fmt.Errorf("name and item are required")
+	}
+
+	item, err := c.characterService.RemoveItem(*c.name, *c.item, *c.quantity)
+	if err != nil {
+		return formatInventoryError(*c.name, *c.item, err)
+	}
+
+	fmt.Printf("Removed %d %s from %s's inventory\n", *c.quantity, item.Name, *c.name)
+	if item.Quantity > 0 {
+		fmt.Printf("Still carrying: %s\n", item)
+	}
+
+	return nil
+}
+
+// Usage prints remove-item command usage
+func (c *RemoveItemCommand) Usage() {
+	fmt.Println("  remove-item -name CHARACTER_NAME -item ITEM [-quantity N]")
+}
+
+// GiveCommand handles adding coins to a character's purse
+type GiveCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	amount *string
+}
+
+// NewGiveCommand creates a new give command
+func NewGiveCommand(characterService *service.CharacterService) *GiveCommand {
+	cmd := &GiveCommand{
+		BaseCommand:      NewBaseCommand("give"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.amount = cmd.flagSet.String("amount", "", "coins to give, e.g. \"15 gp\" (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *GiveCommand) Name() string {
+	return "give"
+}
+
+// Execute gives the coins
+func (c *GiveCommand) Execute() error {
+	if *c.name == "" || *c.amount == "" {
+		return fmt.Errorf("name and amount are required")
+	}
+
+	purse, err := c.characterService.GiveCoins(*c.name, *c.amount)
+	if err != nil {
+		return formatInventoryError(*c.name, "", err)
+	}
+
+	fmt.Printf("%s receives %s\n", *c.name, *c.amount)
+	fmt.Printf("Coins: %s\n", purse)
+
+	return nil
+}
+
+// Usage prints give command usage
+func (c *GiveCommand) Usage() {
+	fmt.Println("  give -name CHARACTER_NAME -amount COINS")
+}
+
+// PayCommand handles spending coins from a character's purse
+type PayCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	amount *string
+}
+
+// NewPayCommand creates a new pay command
+func NewPayCommand(characterService *service.CharacterService) *PayCommand {
+	cmd := &PayCommand{
+		BaseCommand:      NewBaseCommand("pay"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.amount = cmd.flagSet.String("amount", "", "coins to pay, e.g. \"3 gp 5 sp\" (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *PayCommand) Name() string {
+	return "pay"
+}
+
+// Execute pays the coins
+func (c *PayCommand) Execute() error {
+	if *c.name == "" || *c.amount == "" {
+		return fmt.Errorf("name and amount are required")
+	}
+
+	purse, err := c.characterService.PayCoins(*c.name, *c.amount)
+	if err != nil {
+		return formatInventoryError(*c.name, "", err)
+	}
+
+	fmt.Printf("%s pays %s\n", *c.name, *c.amount)
+	fmt.Printf("Coins: %s\n", purse)
+
+	return nil
+}
+
+// Usage prints pay command usage
+func (c *PayCommand) Usage() {
+	fmt.Println("  pay -name CHARACTER_NAME -amount COINS")
+}
+
+// printEncumbrance prints carried weight, carrying capacity and encumbrance status
+func printEncumbrance(char *domain.Character) {
+	fmt.Printf("Carried weight: %s/%s lb (%s)\n", formatWeight(char.CarriedWeight()), formatWeight(char.CarryingCapacity()), char.Encumbrance())
+}
+
+// formatWeight formats a weight in pounds without trailing zeros, e.g. 61.5 or 150
+func formatWeight(pounds float64) string {
+	return strconv.FormatFloat(pounds, 'f', -1, 64)
+}
+
+// EncumbranceCommand handles showing encumbrance and toggling the variant encumbrance rule
+type EncumbranceCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name    *string
+	variant *string
+}
+
+// NewEncumbranceCommand creates a new encumbrance command
+func NewEncumbranceCommand(characterService *service.CharacterService) *EncumbranceCommand {
+	cmd := &EncumbranceCommand{
+		BaseCommand:      NewBaseCommand("encumbrance"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.variant = cmd.flagSet.String("variant", "", "turn the variant encumbrance rule on or off")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *EncumbranceCommand) Name() string {
+	return "encumbrance"
+}
+
+// Execute shows the character's encumbrance
+func (c *EncumbranceCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	var character *domain.Character
+	var err error
+	switch strings.ToLower(*c.variant) {
+	case "":
+		character, err = c.characterService.GetCharacter(*c.name)
+	case "on":
+		character, err = c.characterService.SetVariantEncumbrance(*c.name, true)
+	case "off":
+		character, err = c.characterService.SetVariantEncumbrance(*c.name, false)
+	default:
+		return fmt.Errorf("variant must be on or off")
+	}
+	if err != nil {
+		return err
+	}
+
+	printEncumbrance(character)
+	fmt.Printf("Push, drag or lift: %s lb\n", formatWeight(character.PushDragLift()))
+	if character.VariantEncumbrance {
+		encumbered, heavilyEncumbered := character.EncumbranceThresholds()
+		fmt.Printf("Variant encumbrance: encumbered above %s lb, heavily encumbered above %s lb\n", formatWeight(encumbered), formatWeight(heavilyEncumbered))
+	}
+	fmt.Printf("Speed: %d ft\n", character.Speed())
+
+	return nil
+}
+
+// Usage prints encumbrance command usage
+func (c *EncumbranceCommand) Usage() {
+	fmt.Println("  encumbrance -name CHARACTER_NAME [-variant on|off]")
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+)
+
+// formatAttunementError turns domain attunement errors into messages for the user
+func formatAttunementError(name, item string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrItemNotCarried):
+		return fmt.Errorf("%s doesn't carry %s", name, item)
+	case errors.Is(err, domain.ErrNoAttunement):
+		return fmt.Errorf("%s doesn't require attunement", item)
+	case errors.Is(err, domain.ErrAlreadyAttuned):
+		return fmt.Errorf("%s is already attuned to %s", name, item)
+	case errors.Is(err, domain.ErrAttunementFull):
+		return fmt.Errorf("%s is already attuned to %d magic items, unattune one first", name, domain.MaxAttunedItems)
+	case errors.Is(err, domain.ErrNotAttuned):
+		return fmt.Errorf("%s is not attuned to %s", name, item)
+	}
+	return err
+}
+
+// AttuneCommand handles attuning a character to a magic item
+type AttuneCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+	item *string
+}
+
+// NewAttuneCommand creates a new attune command
+func NewAttuneCommand(characterService *service.CharacterService) *AttuneCommand {
+	cmd := &AttuneCommand{
+		BaseCommand:      NewBaseCommand("attune"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.item = cmd.flagSet.String("item", "", "carried magic item to attune to (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *AttuneCommand) Name() string {
+	return "attune"
+}
+
+// Execute attunes to the item
+func (c *AttuneCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if *c.item == "" {
+		return fmt.Errorf("item is required")
+	}
+
+	item, err := c.characterService.Attune(*c.name, *c.item)
+	if err != nil {
+		return formatAttunementError(*c.name, *c.item, err)
+	}
+
+	fmt.Printf("%s is now attuned to %s (%s)\n", *c.name, item.Name, item.Rarity)
+
+	return nil
+}
+
+// Usage prints attune command usage
+func (c *AttuneCommand) Usage() {
+	fmt.Println("  attune -name CHARACTER_NAME -item MAGIC_ITEM")
+}
+
+// UnattuneCommand handles ending a character's attunement to a magic item
+type UnattuneCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+	item *string
+}
+
+// NewUnattuneCommand creates a new unattune command
+func NewUnattuneCommand(characterService *service.CharacterService) *UnattuneCommand {
+	cmd := &UnattuneCommand{
+		BaseCommand:      NewBaseCommand("unattune"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.item = cmd.flagSet.String("item", "", "magic item to end attunement to (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *UnattuneCommand) Name() string {
+	return "unattune"
+}
+
+// Execute ends the attunement
+func (c *UnattuneCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if *c.item == "" {
+		return fmt.Errorf("item is required")
+	}
+
+	item, err := c.characterService.Unattune(*c.name, *c.item)
+	if err != nil {
+		return formatAttunementError(*c.name, *c.item, err)
+	}
+
+	fmt.Printf("%s is no longer attuned to %s\n", *c.name, item.Name)
+
+	return nil
+}
+
+// Usage prints unattune command usage
+func (c *UnattuneCommand) Usage() {
+	fmt.Println("  unattune -name CHARACTER_NAME -item MAGIC_ITEM")
+}
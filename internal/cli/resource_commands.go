@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+)
+
+// formatResourceError turns domain resource errors into messages for the user
+func formatResourceError(name, resource string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownResource):
+		return fmt.Errorf("%s doesn't have the %s resource", name, resource)
+	case errors.Is(err, domain.ErrResourceDepleted):
+		return fmt.Errorf("%s doesn't have enough %s left", name, resource)
+	case errors.Is(err, domain.ErrInvalidResourceAmount):
+		return fmt.Errorf("invalid amount")
+	}
+	return err
+}
+
+// UseResourceCommand handles spending uses of a class resource
+type UseResourceCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	resource *string
+	amount   *int
+}
+
+// NewUseResourceCommand creates a new use-resource command
+func NewUseResourceCommand(characterService *service.CharacterService) *UseResourceCommand {
+	cmd := &UseResourceCommand{
+		BaseCommand:      NewBaseCommand("use-resource"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.resource = cmd.flagSet.String("resource", "", "resource name, e.g. rage (required)")
+	cmd.amount = cmd.flagSet.Int("amount", 1, "number of uses to spend")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *UseResourceCommand) Name() string {
+	return "use-resource"
+}
+
+// Execute spends the resource
+func (c *UseResourceCommand) Execute() error {
+	if *c.name == "" || *c.resource == "" {
+		return fmt.Errorf("name and resource are required")
+	}
+
+	r, err := c.characterService.UseResource(*c.name, *c.resource, *c.amount)
+	if err != nil {
+		return formatResourceError(*c.name, *c.resource, err)
+	}
+
+	fmt.Printf("%s uses %s\n", *c.name, r.Name)
+	fmt.Printf("%s: %d/%d\n", r.Name, r.Current, r.Max)
+
+	return nil
+}
+
+// Usage prints use-resource command usage
+func (c *UseResourceCommand) Usage() {
+	fmt.Println("  use-resource -name CHARACTER_NAME -resource RESOURCE [-amount N]")
+}
+
+// RestoreResourceCommand handles regaining uses of a class resource outside of a rest
+type RestoreResourceCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	resource *string
+	amount   *int
+}
+
+// NewRestoreResourceCommand creates a new restore-resource command
+func NewRestoreResourceCommand(characterService *service.CharacterService) *RestoreResourceCommand {
+	cmd := &RestoreResourceCommand{
+		BaseCommand:      NewBaseCommand("restore-resource"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.resource = cmd.flagSet.String("resource", "", "resource name, e.g. ki (required)")
+	cmd.amount = cmd.flagSet.Int("amount", 0, "number of uses to regain (0 restores all)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *RestoreResourceCommand) Name() string {
+	return "restore-resource"
+}
+
+// Execute restores the resource
+func (c *RestoreResourceCommand) Execute() error {
+	if *c.name == "" || *c.resource == "" {
+		return fmt.Errorf("name and resource are required")
+	}
+
+	r, err := c.characterService.RestoreResource(*c.name, *c.resource, *c.amount)
+	if err != nil {
+		return formatResourceError(*c.name, *c.resource, err)
+	}
+
+	fmt.Printf("%s regains %s\n", *c.name, r.Name)
+	fmt.Printf("%s: %d/%d\n", r.Name, r.Current, r.Max)
+
+	return nil
+}
+
+// Usage prints restore-resource command usage
+func (c *RestoreResourceCommand) Usage() {
+	fmt.Println("  restore-resource -name CHARACTER_NAME -resource RESOURCE [-amount N]")
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ShortRestCommand handles taking a short rest
+type ShortRestCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name    *string
+	dice    *int
+	average *bool
+}
+
+// NewShortRestCommand creates a new short-rest command
+func NewShortRestCommand(characterService *service.CharacterService) *ShortRestCommand {
+	cmd := &ShortRestCommand{
+		BaseCommand:      NewBaseCommand("short-rest"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.dice = cmd.flagSet.Int("dice", 0, "number of hit dice to spend")
+	cmd.average = cmd.flagSet.Bool("average", false, "take the average of each hit die instead of rolling")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *ShortRestCommand) Name() string {
+	return "short-rest"
+}
+
+// Execute takes a short rest
+func (c *ShortRestCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	result, err := c.characterService.ShortRest(*c.name, *c.dice, *c.average)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoHitDice) {
+			return fmt.Errorf("not enough hit dice remaining")
+		}
+		return formatHitPointError(*c.name, err)
+	}
+
+	fmt.Printf("%s takes a short rest\n", *c.name)
+	if result.HitDiceSpent > 0 {
+		fmt.Printf("Spent %d hit dice and regained %d hit points\n", result.HitDiceSpent, result.HitPointsRegained)
+	}
+	if result.SpellSlotsRestored {
+		fmt.Println("Pact Magic spell slots recovered")
+	}
+	if len(result.ResourcesRestored) > 0 {
+		fmt.Printf("Recharged: %s\n", strings.Join(result.ResourcesRestored, ", "))
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	printHitPoints(character)
+	printSpellSlots(character)
+
+	return nil
+}
+
+// Usage prints short-rest command usage
+func (c *ShortRestCommand) Usage() {
+	fmt.Println("  short-rest -name CHARACTER_NAME [-dice N] [-average]")
+}
+
+// LongRestCommand handles taking a long rest
+type LongRestCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+}
+
+// NewLongRestCommand creates a new long-rest command
+func NewLongRestCommand(characterService *service.CharacterService) *LongRestCommand {
+	cmd := &LongRestCommand{
+		BaseCommand:      NewBaseCommand("long-rest"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *LongRestCommand) Name() string {
+	return "long-rest"
+}
+
+// Execute takes a long rest
+func (c *LongRestCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	result, err := c.characterService.LongRest(*c.name)
+	if err != nil {
+		if errors.Is(err, domain.ErrRestRequiresHitPoints) {
+			return fmt.Errorf("%s needs at least 1 hit point to benefit from a long rest", *c.name)
+		}
+		return formatHitPointError(*c.name, err)
+	}
+
+	fmt.Printf("%s takes a long rest\n", *c.name)
+	fmt.Printf("Regained %d hit points and %d hit dice\n", result.HitPointsRegained, result.HitDiceRecovered)
+	if len(result.ResourcesRestored) > 0 {
+		fmt.Printf("Recharged: %s\n", strings.Join(result.ResourcesRestored, ", "))
+	}
+
+	character, err := c.characterService.GetCharacter(*c.name)
+	if err != nil {
+		return err
+	}
+	if result.ExhaustionRemoved {
+		fmt.Printf("Exhaustion reduced to level %d\n", character.Exhaustion)
+	}
+	printHitPoints(character)
+	printSpellSlots(character)
+
+	return nil
+}
+
+// Usage prints long-rest command usage
+func (c *LongRestCommand) Usage() {
+	fmt.Println("  long-rest -name CHARACTER_NAME")
+}
@@ -52,7 +52,7 @@ func (c *SheetCommand) Execute() error {
 	}
 
 	// Create markdown formatter and export
-	formatter := service.NewMarkdownFormatter()
+	formatter := service.NewMarkdownFormatterWithSpells(c.characterService.GetSpellRepository())
 	markdownOutput := formatter.FormatCharacter(char)
 
 	// Print to stdout
@@ -0,0 +1,277 @@
+package cli
+
+import (
+	"DnD-sheet/internal/character/domain"
+	"DnD-sheet/internal/character/service"
+	"errors"
+	"fmt"
+)
+
+// UnprepareSpellCommand handles removing a spell from a character's prepared spells
+type UnprepareSpellCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name  *string
+	spell *string
+}
+
+// NewUnprepareSpellCommand creates a new unprepare-spell command
+func NewUnprepareSpellCommand(characterService *service.CharacterService) *UnprepareSpellCommand {
+	cmd := &UnprepareSpellCommand{
+		BaseCommand:      NewBaseCommand("unprepare-spell"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.spell = cmd.flagSet.String("spell", "", "prepared spell to remove (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *UnprepareSpellCommand) Name() string {
+	return "unprepare-spell"
+}
+
+// Execute removes the prepared spell
+func (c *UnprepareSpellCommand) Execute() error {
+	if *c.name == "" || *c.spell == "" {
+		return fmt.Errorf("name and spell are required")
+	}
+
+	unprepared, err := c.characterService.UnprepareSpell(*c.name, *c.spell)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is no longer prepared\n", unprepared)
+	return nil
+}
+
+// Usage prints unprepare-spell command usage
+func (c *UnprepareSpellCommand) Usage() {
+	fmt.Println("  unprepare-spell -name CHARACTER_NAME -spell SPELL_NAME")
+}
+
+// ForgetSpellCommand handles removing a spell from a character's known spells
+type ForgetSpellCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name  *string
+	spell *string
+}
+
+// NewForgetSpellCommand creates a new forget-spell command
+func NewForgetSpellCommand(characterService *service.CharacterService) *ForgetSpellCommand {
+	cmd := &ForgetSpellCommand{
+		BaseCommand:      NewBaseCommand("forget-spell"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.spell = cmd.flagSet.String("spell", "", "known spell to forget (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *ForgetSpellCommand) Name() string {
+	return "forget-spell"
+}
+
+// Execute forgets the spell
+func (c *ForgetSpellCommand) Execute() error {
+	if *c.name == "" || *c.spell == "" {
+		return fmt.Errorf("name and spell are required")
+	}
+
+	forgotten, err := c.characterService.ForgetSpell(*c.name, *c.spell)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Forgot spell %s\n", forgotten)
+	return nil
+}
+
+// Usage prints forget-spell command usage
+func (c *ForgetSpellCommand) Usage() {
+	fmt.Println("  forget-spell -name CHARACTER_NAME -spell SPELL_NAME")
+}
+
+// SwapSpellCommand handles replacing a known spell with another one on level up
+type SwapSpellCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name     *string
+	oldSpell *string
+	newSpell *string
+	override *bool
+}
+
+// NewSwapSpellCommand creates a new swap-spell command
+func NewSwapSpellCommand(characterService *service.CharacterService) *SwapSpellCommand {
+	cmd := &SwapSpellCommand{
+		BaseCommand:      NewBaseCommand("swap-spell"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.oldSpell = cmd.flagSet.String("old", "", "known spell to replace (required)")
+	cmd.newSpell = cmd.flagSet.String("new", "", "spell to learn instead (required)")
+	cmd.override = cmd.flagSet.Bool("override", false, "allow a spell from outside the class spell list, e.g. from Magical Secrets")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *SwapSpellCommand) Name() string {
+	return "swap-spell"
+}
+
+// Execute replaces the known spell
+func (c *SwapSpellCommand) Execute() error {
+	if *c.name == "" || *c.oldSpell == "" || *c.newSpell == "" {
+		return fmt.Errorf("name, old and new are required")
+	}
+
+	forgotten, learned, err := c.characterService.SwapSpell(*c.name, *c.oldSpell, *c.newSpell, *c.override)
+	if err != nil {
+		return formatSpellListError(err)
+	}
+
+	fmt.Printf("Replaced %s with %s\n", forgotten, learned)
+	return nil
+}
+
+// Usage prints swap-spell command usage
+func (c *SwapSpellCommand) Usage() {
+	fmt.Println("  swap-spell -name CHARACTER_NAME -old SPELL_NAME -new SPELL_NAME [-override]")
+}
+
+// formatConcentrationError turns domain concentration errors into messages for the user
+func formatConcentrationError(name string, err error) error {
+	if errors.Is(err, domain.ErrNotConcentrating) {
+		return fmt.Errorf("%s is not concentrating on a spell", name)
+	}
+	return err
+}
+
+// ConcentrationCheckCommand handles the Constitution saving throw to keep concentrating after taking damage
+type ConcentrationCheckCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name   *string
+	damage *int
+}
+
+// NewConcentrationCheckCommand creates a new concentration-check command
+func NewConcentrationCheckCommand(characterService *service.CharacterService) *ConcentrationCheckCommand {
+	cmd := &ConcentrationCheckCommand{
+		BaseCommand:      NewBaseCommand("concentration-check"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+	cmd.damage = cmd.flagSet.Int("damage", 0, "damage taken (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *ConcentrationCheckCommand) Name() string {
+	return "concentration-check"
+}
+
+// Execute rolls the concentration check
+func (c *ConcentrationCheckCommand) Execute() error {
+	if *c.name == "" || *c.damage <= 0 {
+		return fmt.Errorf("name and damage (>=1) are required")
+	}
+
+	result, err := c.characterService.ConcentrationCheck(*c.name, *c.damage)
+	if err != nil {
+		return formatConcentrationError(*c.name, err)
+	}
+
+	if result.Mode.AutoFail {
+		fmt.Printf("Constitution save fails automatically (DC %d)\n", result.DC)
+	} else {
+		roll := fmt.Sprintf("d20 %d", result.D20)
+		if mode := result.Mode.String(); mode != "" {
+			roll += " with " + mode
+		}
+		fmt.Printf("Constitution save: %d (%s) against DC %d\n", result.Total, roll, result.DC)
+	}
+	if result.Success {
+		fmt.Printf("%s keeps concentrating on %s\n", *c.name, result.Spell)
+	} else {
+		fmt.Printf("%s loses concentration on %s\n", *c.name, result.Spell)
+	}
+
+	return nil
+}
+
+// Usage prints concentration-check command usage
+func (c *ConcentrationCheckCommand) Usage() {
+	fmt.Println("  concentration-check -name CHARACTER_NAME -damage N")
+}
+
+// EndConcentrationCommand handles ending concentration on a spell
+type EndConcentrationCommand struct {
+	*BaseCommand
+	characterService *service.CharacterService
+
+	// Flags
+	name *string
+}
+
+// NewEndConcentrationCommand creates a new end-concentration command
+func NewEndConcentrationCommand(characterService *service.CharacterService) *EndConcentrationCommand {
+	cmd := &EndConcentrationCommand{
+		BaseCommand:      NewBaseCommand("end-concentration"),
+		characterService: characterService,
+	}
+
+	// Define flags
+	cmd.name = cmd.flagSet.String("name", "", "character name (required)")
+
+	return cmd
+}
+
+// Name returns the command name
+func (c *EndConcentrationCommand) Name() string {
+	return "end-concentration"
+}
+
+// Execute ends the concentration
+func (c *EndConcentrationCommand) Execute() error {
+	if *c.name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	ended, err := c.characterService.EndConcentration(*c.name)
+	if err != nil {
+		return formatConcentrationError(*c.name, err)
+	}
+
+	fmt.Printf("%s stops concentrating on %s\n", *c.name, ended)
+	return nil
+}
+
+// Usage prints end-concentration command usage
+func (c *EndConcentrationCommand) Usage() {
+	fmt.Println("  end-concentration -name CHARACTER_NAME")
+}
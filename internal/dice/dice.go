@@ -0,0 +1,30 @@
+package dice
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Roller rolls a single die with the given number of sides
+// It is an interface so game rules can be exercised with fixed rolls
+type Roller interface {
+	Roll(sides int) int
+}
+
+// RandomRoller rolls dice using a pseudo-random number generator
+type RandomRoller struct {
+	rng *rand.Rand
+}
+
+// NewRandomRoller creates a roller seeded from the current time
+func NewRandomRoller() *RandomRoller {
+	return &RandomRoller{rng: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// Roll returns a value between 1 and sides (inclusive)
+func (r *RandomRoller) Roll(sides int) int {
+	if sides < 1 {
+		return 0
+	}
+	return r.rng.Intn(sides) + 1
+}
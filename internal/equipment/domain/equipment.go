@@ -1,11 +1,25 @@
 package domain
 
-import "strings"
+import (
+	"DnD-sheet/internal/textmatch"
+	"errors"
+	"strings"
+)
+
+// ErrEquipmentNotFound indicates an item that isn't in the equipment catalog
+var ErrEquipmentNotFound = errors.New("unknown equipment")
 
 // ArmorClass represents armor class statistics
 type ArmorClass struct {
 	Base     int  `json:"base"`
 	DexBonus bool `json:"dex_bonus"`
+	MaxBonus int  `json:"max_bonus,omitempty"` // 0 means the full Dex bonus applies
+}
+
+// WeaponRange represents a weapon's normal and long range in feet
+type WeaponRange struct {
+	Normal int `json:"normal,omitempty"`
+	Long   int `json:"long,omitempty"`
 }
 
 // Equipment represents a piece of equipment in D&D
@@ -13,6 +27,22 @@ type Equipment struct {
 	Name       string     `json:"name"`
 	Category   string     `json:"category"`
 	ArmorClass ArmorClass `json:"armor_class"`
+	Weight     float64    `json:"weight,omitempty"` // in pounds, per item
+
+	// Armor fields, empty for other equipment
+	ArmorCategory       string `json:"armor_category,omitempty"` // Light, Medium, Heavy or Shield
+	StrMinimum          int    `json:"str_minimum,omitempty"`
+	StealthDisadvantage bool   `json:"stealth_disadvantage,omitempty"`
+
+	// Weapon fields, empty for other equipment (named after the D&D 5e API's weapon data)
+	WeaponCategory  string      `json:"weapon_category,omitempty"` // Simple or Martial
+	WeaponRange     string      `json:"weapon_range,omitempty"`    // Melee or Ranged
+	Damage          string      `json:"damage,omitempty"`          // damage dice, e.g. "1d8"
+	DamageType      string      `json:"damage_type,omitempty"`
+	Range           WeaponRange `json:"range,omitempty"`
+	Properties      []string    `json:"properties,omitempty"`       // e.g. "Finesse", "Two-Handed"
+	VersatileDamage string      `json:"versatile_damage,omitempty"` // damage dice when wielded with two hands
+	Ammunition      string      `json:"ammunition,omitempty"`       // ammunition item the weapon fires, e.g. "Arrow"
 	// Additional fields can be added here as needed
 }
 
@@ -21,37 +51,60 @@ type EquipmentRepository interface {
 	// LoadAll loads all equipment from the data source
 	LoadAll() ([]Equipment, error)
 
-	// FindByName finds equipment by name (case-insensitive)
+	// FindByName finds equipment by name (case-insensitive); unknown items give ErrEquipmentNotFound
+	// with suggestions for similar names
 	FindByName(name string) (*Equipment, error)
 
 	// FindByCategory returns all equipment in a specific category
 	FindByCategory(category string) ([]Equipment, error)
 }
 
+// IsArmor checks if the equipment is body armor
+func (e *Equipment) IsArmor() bool {
+	return e.ArmorCategory != "" && !e.IsShield()
+}
+
+// IsShield checks if the equipment is a shield
+func (e *Equipment) IsShield() bool {
+	return e.ArmorCategory == "Shield"
+}
+
+// IsWeapon checks if the equipment is a weapon
+func (e *Equipment) IsWeapon() bool {
+	return e.WeaponCategory != ""
+}
+
+// HasProperty checks if the weapon has a property such as "Two-Handed" (case-insensitive)
+func (e *Equipment) HasProperty(property string) bool {
+	for _, p := range e.Properties {
+		if strings.EqualFold(p, property) {
+			return true
+		}
+	}
+	return false
+}
+
 // CalculateAC calculates the armor class with dexterity modifier according to D&D 5e armor rules
 func (e *Equipment) CalculateAC(dexModifier int) int {
 	ac := e.ArmorClass.Base
 
+	// Light armor adds the full Dex bonus, medium armor caps it (max_bonus) and heavy armor adds none
 	if e.ArmorClass.DexBonus {
-		// Apply dex bonus based on armor category (D&D 5e rules)
-		switch strings.ToLower(e.Category) {
-		case "medium armor":
-			// Medium armor: max +2 dex bonus
-			if dexModifier > 2 {
-				ac += 2
-			} else {
-				ac += dexModifier
-			}
-		case "light armor":
-			// Light armor: full dex bonus
-			ac += dexModifier
-		case "heavy armor":
-			// Heavy armor: no dex bonus
-		default:
-			// Default: full dex bonus (for shields, etc.)
+		if e.ArmorClass.MaxBonus > 0 {
+			ac += min(dexModifier, e.ArmorClass.MaxBonus)
+		} else {
 			ac += dexModifier
 		}
 	}
 
 	return ac
 }
+
+// Suggest returns up to limit item names that look like a misspelling of name, closest first
+func Suggest(items []Equipment, name string, limit int) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+	return textmatch.Suggest(names, name, limit)
+}
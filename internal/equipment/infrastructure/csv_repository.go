@@ -4,11 +4,15 @@ import (
 	"DnD-sheet/internal/equipment/domain"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 )
 
+// maxSuggestions is the number of similar item names offered for an unknown item
+const maxSuggestions = 3
+
 // CSVEquipmentRepository implements equipment persistence using CSV files
 type CSVEquipmentRepository struct {
 	csvPath   string
@@ -35,14 +39,17 @@ func (r *CSVEquipmentRepository) FindByName(name string) (*domain.Equipment, err
 		return nil, err
 	}
 
-	name = strings.ToLower(strings.TrimSpace(name))
+	trimmed := strings.TrimSpace(name)
 	for _, eq := range r.equipment {
-		eqName := strings.ToLower(strings.TrimSpace(eq.Name))
-		if eqName == name {
+		if strings.EqualFold(strings.TrimSpace(eq.Name), trimmed) {
 			return &eq, nil
 		}
 	}
-	return nil, errors.New("equipment not found: " + name)
+
+	if suggestions := domain.Suggest(r.equipment, trimmed, maxSuggestions); len(suggestions) > 0 {
+		return nil, fmt.Errorf("%w %q, did you mean %s?", domain.ErrEquipmentNotFound, trimmed, strings.Join(suggestions, ", "))
+	}
+	return nil, fmt.Errorf("%w %q", domain.ErrEquipmentNotFound, trimmed)
 }
 
 // FindByCategory returns all equipment in a specific category
@@ -80,6 +87,22 @@ func (r *CSVEquipmentRepository) ensureLoaded() error {
 		return err
 	}
 
+	if len(records) == 0 {
+		return errors.New("equipment catalog is empty: " + r.csvPath)
+	}
+
+	// Optional columns are looked up by their header so the catalog can grow new columns
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	field := func(rec []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+
 	var equipmentList []domain.Equipment
 	for i, rec := range records {
 		if i == 0 {
@@ -90,12 +113,26 @@ func (r *CSVEquipmentRepository) ensureLoaded() error {
 		}
 
 		ac := domain.ArmorClass{Base: 10} // default armor class
-		if len(rec) > 3 {
-			if base, err := strconv.Atoi(strings.TrimSpace(rec[2])); err == nil {
-				ac.Base = base
-			}
-			if dexBonus := strings.TrimSpace(rec[3]); strings.EqualFold(dexBonus, "yes") {
-				ac.DexBonus = true
+		if base, err := strconv.Atoi(field(rec, "armor_class")); err == nil {
+			ac.Base = base
+		}
+		if strings.EqualFold(field(rec, "dex_bonus"), "yes") {
+			ac.DexBonus = true
+		}
+		ac.MaxBonus, _ = strconv.Atoi(field(rec, "max_dex_bonus"))
+
+		weight, _ := strconv.ParseFloat(field(rec, "weight"), 64)
+		strMinimum, _ := strconv.Atoi(field(rec, "str_minimum"))
+
+		var weaponRange domain.WeaponRange
+		weaponRange.Normal, _ = strconv.Atoi(field(rec, "range_normal"))
+		weaponRange.Long, _ = strconv.Atoi(field(rec, "range_long"))
+
+		// Properties are separated by semicolons, e.g. "Finesse;Light;Thrown"
+		var properties []string
+		for _, p := range strings.Split(field(rec, "properties"), ";") {
+			if p = strings.TrimSpace(p); p != "" {
+				properties = append(properties, p)
 			}
 		}
 
@@ -103,6 +140,20 @@ func (r *CSVEquipmentRepository) ensureLoaded() error {
 			Name:       strings.TrimSpace(rec[0]),
 			Category:   strings.TrimSpace(rec[1]),
 			ArmorClass: ac,
+			Weight:     weight,
+
+			ArmorCategory:       field(rec, "armor_category"),
+			StrMinimum:          strMinimum,
+			StealthDisadvantage: strings.EqualFold(field(rec, "stealth_disadvantage"), "yes"),
+
+			WeaponCategory:  field(rec, "weapon_category"),
+			WeaponRange:     field(rec, "weapon_range"),
+			Damage:          field(rec, "damage_dice"),
+			DamageType:      field(rec, "damage_type"),
+			Range:           weaponRange,
+			Properties:      properties,
+			VersatileDamage: field(rec, "versatile_damage"),
+			Ammunition:      field(rec, "ammunition"),
 		})
 	}
 
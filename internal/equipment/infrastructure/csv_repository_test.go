@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"DnD-sheet/internal/equipment/domain"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeCatalog writes a CSV equipment catalog to a temporary file and returns a repository for it
+func writeCatalog(t *testing.T, content string) *CSVEquipmentRepository {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "equipment.csv")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return NewCSVEquipmentRepository(path)
+}
+
+func TestCSVEquipmentRepository_NameAndTypeColumns(t *testing.T) {
+	// Catalogs from before the armor and weapon columns only have a name and type
+	repo := writeCatalog(t, "name,type\nRope,Adventuring Gear\n")
+
+	items, err := repo.LoadAll()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("Expected 1 item, got %+v", items)
+	}
+	rope := items[0]
+	if rope.Name != "Rope" || rope.Category != "Adventuring Gear" || rope.ArmorClass.Base != 10 || rope.IsWeapon() || rope.IsArmor() {
+		t.Errorf("Expected plain adventuring gear with the default AC, got %+v", rope)
+	}
+}
+
+func TestCSVEquipmentRepository_AllColumns(t *testing.T) {
+	repo := writeCatalog(t, `name,type,weight,armor_category,armor_class,dex_bonus,max_dex_bonus,str_minimum,stealth_disadvantage,weapon_category,weapon_range,damage_dice,damage_type,range_normal,range_long,properties,versatile_damage,ammunition
+Longbow,Weapon,2,,,,,,,Martial,Ranged,1d8,Piercing,150,600,Ammunition;Heavy;Two-Handed,,Arrow
+Longsword,Weapon,3,,,,,,,Martial,Melee,1d8,Slashing,5,,Versatile,1d10,
+Half Plate,Armor,40,Medium,15,yes,2,,yes,,,,,,,,,
+Plate Armor,Armor,65,Heavy,18,,,15,yes,,,,,,,,,
+`)
+
+	longbow, err := repo.FindByName("Longbow")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if longbow.WeaponCategory != "Martial" || longbow.WeaponRange != "Ranged" || longbow.Damage != "1d8" || longbow.DamageType != "Piercing" ||
+		longbow.Range.Normal != 150 || longbow.Range.Long != 600 || longbow.Ammunition != "Arrow" || longbow.Weight != 2 {
+		t.Errorf("Unexpected longbow stats %+v", longbow)
+	}
+	if len(longbow.Properties) != 3 || !longbow.HasProperty("heavy") {
+		t.Errorf("Expected three properties including Heavy, got %v", longbow.Properties)
+	}
+
+	longsword, _ := repo.FindByName("Longsword")
+	if longsword.VersatileDamage != "1d10" {
+		t.Errorf("Expected versatile damage 1d10, got %q", longsword.VersatileDamage)
+	}
+
+	halfPlate, _ := repo.FindByName("Half Plate")
+	if halfPlate.ArmorCategory != "Medium" || halfPlate.CalculateAC(4) != 17 || !halfPlate.StealthDisadvantage {
+		t.Errorf("Expected medium armor with AC 15 + Dex (max 2), got %+v", halfPlate)
+	}
+
+	plate, _ := repo.FindByName("Plate Armor")
+	if plate.StrMinimum != 15 || plate.CalculateAC(3) != 18 {
+		t.Errorf("Expected heavy armor with AC 18 and Str 15, got %+v", plate)
+	}
+
+	weapons, err := repo.FindByCategory("weapon")
+	if err != nil || len(weapons) != 2 {
+		t.Errorf("Expected 2 weapons, got %d (err %v)", len(weapons), err)
+	}
+}
+
+func TestCSVEquipmentRepository_FindByName(t *testing.T) {
+	repo := writeCatalog(t, "name,type\nLongsword,Weapon\nShortsword,Weapon\nRope,Adventuring Gear\n")
+
+	item, err := repo.FindByName("  LONGSWORD ")
+	if err != nil || item.Name != "Longsword" {
+		t.Errorf("Expected a case-insensitive match on Longsword, got %+v (err %v)", item, err)
+	}
+
+	_, err = repo.FindByName("longswrd")
+	if !errors.Is(err, domain.ErrEquipmentNotFound) || !strings.Contains(err.Error(), "did you mean Longsword") {
+		t.Errorf("Expected an unknown equipment error suggesting Longsword, got %v", err)
+	}
+
+	_, err = repo.FindByName("warhammer of doom")
+	if !errors.Is(err, domain.ErrEquipmentNotFound) || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("Expected an unknown equipment error without suggestions, got %v", err)
+	}
+}
+
+func TestCSVEquipmentRepository_MissingFile(t *testing.T) {
+	repo := NewCSVEquipmentRepository(filepath.Join(t.TempDir(), "missing.csv"))
+	if _, err := repo.LoadAll(); err == nil {
+		t.Error("Expected an error for a missing catalog file")
+	}
+}
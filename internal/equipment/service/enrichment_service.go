@@ -0,0 +1,156 @@
+package service
+
+import (
+	"DnD-sheet/internal/api"
+	"DnD-sheet/internal/equipment/domain"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// EnrichmentService handles enriching catalog equipment with API data
+type EnrichmentService struct {
+	equipment *EquipmentService
+	apiClient *api.Client
+}
+
+// NewEnrichmentService creates a new equipment enrichment service on top of the equipment catalog
+func NewEnrichmentService(equipment *EquipmentService) *EnrichmentService {
+	return &EnrichmentService{
+		equipment: equipment,
+		apiClient: api.NewClient(),
+	}
+}
+
+// Close closes the API client
+func (s *EnrichmentService) Close() {
+	if s.apiClient != nil {
+		s.apiClient.Close()
+	}
+}
+
+// EnrichEquipment enriches a single equipment item with API data
+func (s *EnrichmentService) EnrichEquipment(equipment domain.Equipment) domain.Equipment {
+	equipmentData, err := s.apiClient.GetEquipment(equipment.Name)
+	if err != nil {
+		log.Printf("Failed to enrich equipment '%s': %v", equipment.Name, err)
+		return equipment
+	}
+
+	if !applyAPIData(&equipment, equipmentData) {
+		log.Printf("Unknown equipment type for '%s'", equipment.Name)
+	}
+	return equipment
+}
+
+// EnrichEquipmentBatch enriches multiple equipment items concurrently
+func (s *EnrichmentService) EnrichEquipmentBatch(equipment []domain.Equipment) []domain.Equipment {
+	if len(equipment) == 0 {
+		return nil
+	}
+
+	// Extract equipment names for batch request
+	equipmentNames := make([]string, len(equipment))
+	equipmentMap := make(map[string]domain.Equipment)
+
+	for i, eq := range equipment {
+		equipmentNames[i] = eq.Name
+		equipmentMap[eq.Name] = eq
+	}
+
+	// Make batch API request
+	results := s.apiClient.GetEquipmentBatch(equipmentNames)
+
+	// Process results
+	enrichedEquipment := make([]domain.Equipment, 0, len(equipment))
+
+	for _, result := range results {
+		enriched := equipmentMap[result.Name]
+
+		if result.Error != nil {
+			log.Printf("Failed to enrich equipment '%s': %v", result.Name, result.Error)
+		} else {
+			applyAPIData(&enriched, result.Data)
+		}
+
+		enrichedEquipment = append(enrichedEquipment, enriched)
+	}
+
+	return enrichedEquipment
+}
+
+// applyAPIData fills in weapon or armor stats from the D&D 5e API, reporting whether the data was recognised
+func applyAPIData(equipment *domain.Equipment, data interface{}) bool {
+	switch data := data.(type) {
+	case *api.WeaponDetails:
+		equipment.WeaponCategory = data.WeaponCategory
+		equipment.WeaponRange = data.WeaponRange
+		equipment.Range = domain.WeaponRange{
+			Normal: data.Range.Normal,
+			Long:   data.Range.Long,
+		}
+		equipment.Damage = data.Damage.DamageDice
+		equipment.DamageType = data.Damage.DamageType.Name
+
+		properties := make([]string, len(data.Properties))
+		for i, prop := range data.Properties {
+			properties[i] = prop.Name
+		}
+		equipment.Properties = properties
+
+	case *api.ArmorDetails:
+		equipment.ArmorCategory = data.ArmorCategory
+		equipment.ArmorClass = domain.ArmorClass{
+			Base:     data.ArmorClass.Base,
+			DexBonus: data.ArmorClass.DexBonus,
+			MaxBonus: data.ArmorClass.MaxBonus,
+		}
+		equipment.StrMinimum = data.StrMinimum
+		equipment.StealthDisadvantage = data.StealthDisadvantage
+
+	default:
+		return false
+	}
+	return true
+}
+
+// SearchEquipment searches the catalog for equipment by name or category and enriches the matches
+func (s *EnrichmentService) SearchEquipment(query string, limit int) ([]domain.Equipment, error) {
+	queryLower := strings.ToLower(query)
+	return s.enrichMatching(limit, func(eq domain.Equipment) bool {
+		return strings.Contains(strings.ToLower(eq.Name), queryLower) ||
+			strings.Contains(strings.ToLower(eq.Category), queryLower)
+	})
+}
+
+// GetWeapons returns weapons from the catalog with enrichment
+func (s *EnrichmentService) GetWeapons(limit int) ([]domain.Equipment, error) {
+	return s.enrichMatching(limit, func(eq domain.Equipment) bool { return eq.IsWeapon() })
+}
+
+// GetArmor returns armor and shields from the catalog with enrichment
+func (s *EnrichmentService) GetArmor(limit int) ([]domain.Equipment, error) {
+	return s.enrichMatching(limit, func(eq domain.Equipment) bool { return eq.IsArmor() || eq.IsShield() })
+}
+
+// enrichMatching enriches up to limit catalog items that match
+func (s *EnrichmentService) enrichMatching(limit int, match func(domain.Equipment) bool) ([]domain.Equipment, error) {
+	equipment, err := s.equipment.GetAllEquipment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load equipment: %w", err)
+	}
+
+	var matched []domain.Equipment
+	for _, eq := range equipment {
+		if match(eq) {
+			matched = append(matched, eq)
+
+			// Limit results for testing
+			if len(matched) >= limit {
+				break
+			}
+		}
+	}
+
+	return s.EnrichEquipmentBatch(matched), nil
+}
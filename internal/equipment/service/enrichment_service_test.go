@@ -0,0 +1,64 @@
+package service
+
+import (
+	"DnD-sheet/internal/api"
+	"DnD-sheet/internal/equipment/domain"
+	"encoding/json"
+	"testing"
+)
+
+func TestApplyAPIData_Weapon(t *testing.T) {
+	var data api.WeaponDetails
+	err := json.Unmarshal([]byte(`{
+		"name": "Longbow",
+		"weapon_category": "Martial",
+		"weapon_range": "Ranged",
+		"range": {"normal": 150, "long": 600},
+		"damage": {"damage_dice": "1d8", "damage_type": {"name": "Piercing"}},
+		"properties": [{"name": "Ammunition"}, {"name": "Heavy"}, {"name": "Two-Handed"}]
+	}`), &data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	longbow := domain.Equipment{Name: "Longbow", Category: "Weapon"}
+	if !applyAPIData(&longbow, &data) {
+		t.Fatal("Expected weapon data to be recognised")
+	}
+	if longbow.WeaponCategory != "Martial" || longbow.WeaponRange != "Ranged" || longbow.Range.Normal != 150 || longbow.Range.Long != 600 ||
+		longbow.Damage != "1d8" || longbow.DamageType != "Piercing" || len(longbow.Properties) != 3 || !longbow.HasProperty("heavy") {
+		t.Errorf("Unexpected enriched weapon %+v", longbow)
+	}
+}
+
+func TestApplyAPIData_Armor(t *testing.T) {
+	var data api.ArmorDetails
+	err := json.Unmarshal([]byte(`{
+		"name": "Half Plate Armor",
+		"armor_category": "Medium",
+		"armor_class": {"base": 15, "dex_bonus": true, "max_bonus": 2},
+		"str_minimum": 0,
+		"stealth_disadvantage": true
+	}`), &data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	halfPlate := domain.Equipment{Name: "Half Plate Armor", Category: "Armor"}
+	if !applyAPIData(&halfPlate, &data) {
+		t.Fatal("Expected armor data to be recognised")
+	}
+	if halfPlate.ArmorCategory != "Medium" || halfPlate.CalculateAC(3) != 17 || !halfPlate.StealthDisadvantage {
+		t.Errorf("Unexpected enriched armor %+v", halfPlate)
+	}
+}
+
+func TestApplyAPIData_UnknownType(t *testing.T) {
+	rope := domain.Equipment{Name: "Rope", Category: "Adventuring Gear"}
+	if applyAPIData(&rope, "not equipment data") {
+		t.Error("Expected unrecognised API data to be reported")
+	}
+	if rope.IsWeapon() || rope.IsArmor() {
+		t.Errorf("Expected the item to be unchanged, got %+v", rope)
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"DnD-sheet/internal/textmatch"
+	"errors"
+	"strings"
+)
+
+// ErrSpellNotFound indicates a spell that isn't in the spell catalog
+var ErrSpellNotFound = errors.New("unknown spell")
+
+// Spell represents a spell from the D&D 5e SRD spell list
+type Spell struct {
+	Name          string   `json:"name"`
+	Level         int      `json:"level"`                   // 0 for cantrips
+	Classes       []string `json:"classes"`                 // classes whose spell list includes the spell
+	Concentration bool     `json:"concentration,omitempty"` // the caster must concentrate to maintain the spell
+	HigherLevel   string   `json:"higher_level,omitempty"`  // "At Higher Levels" text, empty if slot level doesn't matter
+}
+
+// EnrichedSpell represents a catalog spell with additional data from the D&D 5e API
+type EnrichedSpell struct {
+	Spell
+	School      string   `json:"school,omitempty"`
+	Range       string   `json:"range,omitempty"`
+	Components  []string `json:"components,omitempty"`
+	Duration    string   `json:"duration,omitempty"`
+	CastingTime string   `json:"casting_time,omitempty"`
+	Description []string `json:"description,omitempty"`
+	Ritual      bool     `json:"ritual,omitempty"`
+}
+
+// SpellRepository defines the interface for spell data access
+type SpellRepository interface {
+	// LoadAll loads all spells from the data source
+	LoadAll() ([]Spell, error)
+
+	// FindByName finds a spell by name (case-insensitive); unknown spells give ErrSpellNotFound
+	// with suggestions for similar names
+	FindByName(name string) (*Spell, error)
+
+	// FindByClass returns all spells on a class's spell list
+	FindByClass(class string) ([]Spell, error)
+}
+
+// IsCantrip checks if the spell is a cantrip
+func (s *Spell) IsCantrip() bool {
+	return s.Level == 0
+}
+
+// HasClass checks if the spell is on a class's spell list (case-insensitive)
+func (s *Spell) HasClass(class string) bool {
+	for _, c := range s.Classes {
+		if strings.EqualFold(c, strings.TrimSpace(class)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Suggest returns up to limit spell names that look like a misspelling of name, closest first
+func Suggest(spells []Spell, name string, limit int) []string {
+	names := make([]string, len(spells))
+	for i, spell := range spells {
+		names[i] = spell.Name
+	}
+	return textmatch.Suggest(names, name, limit)
+}
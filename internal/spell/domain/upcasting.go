@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// extraDice matches "increases by 1d6 for each slot level above 3rd"
+	extraDice = regexp.MustCompile(`(?i)(\d+)d(\d+) for each slot level above (\d)`)
+
+	// extraTargets matches "creates one more dart for each slot level above 1st"
+	extraTargets = regexp.MustCompile(`(?i)\b(one|two|three|four) (more|additional) ([a-z]+) for each slot level above (\d)`)
+
+	numberWords = map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+)
+
+// Ordinal returns a spell level as it is written in spell descriptions, e.g. "3rd"
+func Ordinal(level int) string {
+	switch {
+	case level%100 >= 11 && level%100 <= 13:
+		return fmt.Sprintf("%dth", level)
+	case level%10 == 1:
+		return fmt.Sprintf("%dst", level)
+	case level%10 == 2:
+		return fmt.Sprintf("%dnd", level)
+	case level%10 == 3:
+		return fmt.Sprintf("%drd", level)
+	default:
+		return fmt.Sprintf("%dth", level)
+	}
+}
+
+// UpcastEffect works out the extra effect of casting a spell with a slot of slotLevel from its "At Higher Levels"
+// text, e.g. "+2d6" for Fireball with a 5th level slot or "2 more darts" for Magic Missile with a 3rd level slot
+// Returns an empty string if the text doesn't scale per slot level in a way it recognizes
+func UpcastEffect(higherLevel string, slotLevel int) string {
+	var effects []string
+	for _, m := range extraDice.FindAllStringSubmatch(higherLevel, -1) {
+		dice, _ := strconv.Atoi(m[1])
+		above, _ := strconv.Atoi(m[3])
+		if levels := slotLevel - above; levels > 0 {
+			effects = append(effects, fmt.Sprintf("+%dd%s", dice*levels, m[2]))
+		}
+	}
+	for _, m := range extraTargets.FindAllStringSubmatch(higherLevel, -1) {
+		above, _ := strconv.Atoi(m[4])
+		if levels := slotLevel - above; levels > 0 {
+			n := numberWords[strings.ToLower(m[1])] * levels
+			effects = append(effects, fmt.Sprintf("%d %s %s", n, strings.ToLower(m[2]), plural(m[3], n)))
+		}
+	}
+	return strings.Join(effects, ", ")
+}
+
+// plural adds an "s" to a noun for more than one
+func plural(noun string, n int) string {
+	if n == 1 || strings.HasSuffix(noun, "s") {
+		return noun
+	}
+	return noun + "s"
+}
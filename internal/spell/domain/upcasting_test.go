@@ -0,0 +1,33 @@
+package domain
+
+import "testing"
+
+func TestUpcastEffect(t *testing.T) {
+	tests := []struct {
+		name        string
+		higherLevel string
+		slotLevel   int
+		expected    string
+	}{
+		{"Extra damage dice", "When you cast this spell using a spell slot of 4th level or higher, the damage increases by 1d6 for each slot level above 3rd.", 5, "+2d6"},
+		{"Extra darts", "When you cast this spell using a spell slot of 2nd level or higher, the spell creates one more dart for each slot level above 1st.", 3, "2 more darts"},
+		{"Extra target", "When you cast this spell using a spell slot of 3rd level or higher, you can target one additional humanoid for each slot level above 2nd.", 3, "1 additional humanoid"},
+		{"Text without scaling", "When you cast this spell using a spell slot of 4th level or higher, the duration is 8 hours.", 4, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpcastEffect(tt.higherLevel, tt.slotLevel); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for level, expected := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 9: "9th"} {
+		if got := Ordinal(level); got != expected {
+			t.Errorf("Expected %s, got %s", expected, got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package infrastructure
+
+import (
+	"DnD-sheet/internal/spell/domain"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// maxSuggestions is the number of similar spell names offered for an unknown spell
+const maxSuggestions = 3
+
+// CSVSpellRepository implements spell persistence using CSV files
+type CSVSpellRepository struct {
+	csvPath string
+	spells  []domain.Spell
+	loaded  bool
+}
+
+// NewCSVSpellRepository creates a new CSV spell repository
+func NewCSVSpellRepository(csvPath string) *CSVSpellRepository {
+	return &CSVSpellRepository{csvPath: csvPath}
+}
+
+// LoadAll loads all spells from the CSV file
+func (r *CSVSpellRepository) LoadAll() ([]domain.Spell, error) {
+	if err := r.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	return r.spells, nil
+}
+
+// FindByName finds a spell by name (case-insensitive)
+func (r *CSVSpellRepository) FindByName(name string) (*domain.Spell, error) {
+	if err := r.ensureLoaded(); err != nil {
+		return nil, err
+	}
+
+	trimmed := strings.TrimSpace(name)
+	for _, spell := range r.spells {
+		if strings.EqualFold(spell.Name, trimmed) {
+			return &spell, nil
+		}
+	}
+
+	if suggestions := domain.Suggest(r.spells, trimmed, maxSuggestions); len(suggestions) > 0 {
+		return nil, fmt.Errorf("%w %q, did you mean %s?", domain.ErrSpellNotFound, trimmed, strings.Join(suggestions, ", "))
+	}
+	return nil, fmt.Errorf("%w %q", domain.ErrSpellNotFound, trimmed)
+}
+
+// FindByClass returns all spells on a class's spell list
+func (r *CSVSpellRepository) FindByClass(class string) ([]domain.Spell, error) {
+	if err := r.ensureLoaded(); err != nil {
+		return nil, err
+	}
+
+	var result []domain.Spell
+	for _, spell := range r.spells {
+		if spell.HasClass(class) {
+			result = append(result, spell)
+		}
+	}
+	return result, nil
+}
+
+// ensureLoaded loads the CSV data if not already loaded
+func (r *CSVSpellRepository) ensureLoaded() error {
+	if r.loaded {
+		return nil
+	}
+
+	file, err := os.Open(r.csvPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return err
+	}
+
+	var spells []domain.Spell
+	for i, rec := range records {
+		if i == 0 {
+			continue // skip header
+		}
+		if len(rec) < 3 {
+			continue // skip incomplete or empty rows
+		}
+		level, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil {
+			return fmt.Errorf("invalid level for spell %q: %w", rec[0], err)
+		}
+
+		var classes []string
+		for _, class := range strings.Split(rec[2], ",") {
+			if class = strings.TrimSpace(class); class != "" {
+				classes = append(classes, class)
+			}
+		}
+		// The concentration and higher_level columns are optional
+		higherLevel := ""
+		if len(rec) > 4 {
+			higherLevel = strings.TrimSpace(rec[4])
+		}
+		spells = append(spells, domain.Spell{
+			Name:          strings.TrimSpace(rec[0]),
+			Level:         level,
+			Classes:       classes,
+			Concentration: len(rec) > 3 && strings.EqualFold(strings.TrimSpace(rec[3]), "yes"),
+			HigherLevel:   higherLevel,
+		})
+	}
+
+	r.spells = spells
+	r.loaded = true
+	return nil
+}
@@ -0,0 +1,43 @@
+package infrastructure
+
+import (
+	"DnD-sheet/internal/spell/domain"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testSpells is the SRD spell catalog shipped with the application
+var testSpells = NewCSVSpellRepository("../5e-SRD-Spells.csv")
+
+func TestCSVSpellRepository_Suggestions(t *testing.T) {
+	_, err := testSpells.FindByName("Heling Word")
+	if !errors.Is(err, domain.ErrSpellNotFound) || !strings.Contains(err.Error(), "did you mean Healing Word") {
+		t.Errorf("Expected an unknown spell error suggesting Healing Word, got %v", err)
+	}
+
+	spell, err := testSpells.FindByName("cure wounds")
+	if err != nil || spell.Name != "Cure Wounds" || spell.Level != 1 || !spell.HasClass("cleric") {
+		t.Errorf("Expected the level 1 cleric spell Cure Wounds, got %+v (err %v)", spell, err)
+	}
+}
+
+func TestCSVSpellRepository_OptionalColumns(t *testing.T) {
+	fireball, err := testSpells.FindByName("Fireball")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fireball.Concentration || !strings.Contains(fireball.HigherLevel, "1d6 for each slot level above 3rd") {
+		t.Errorf("Expected Fireball without concentration and with its higher level text, got %+v", fireball)
+	}
+
+	bless, _ := testSpells.FindByName("Bless")
+	if !bless.Concentration {
+		t.Errorf("Expected Bless to require concentration, got %+v", bless)
+	}
+
+	shield, _ := testSpells.FindByName("Shield")
+	if shield.HigherLevel != "" {
+		t.Errorf("Expected no higher level text for Shield, got %q", shield.HigherLevel)
+	}
+}
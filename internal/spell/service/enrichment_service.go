@@ -0,0 +1,131 @@
+package service
+
+import (
+	"DnD-sheet/internal/api"
+	"DnD-sheet/internal/spell/domain"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// EnrichmentService handles enriching catalog spells with API data
+type EnrichmentService struct {
+	spells    domain.SpellRepository
+	apiClient *api.Client
+}
+
+// NewEnrichmentService creates a new spell enrichment service on top of the spell catalog
+func NewEnrichmentService(spells domain.SpellRepository) *EnrichmentService {
+	return &EnrichmentService{
+		spells:    spells,
+		apiClient: api.NewClient(),
+	}
+}
+
+// Close closes the API client
+func (s *EnrichmentService) Close() {
+	if s.apiClient != nil {
+		s.apiClient.Close()
+	}
+}
+
+// EnrichSpell enriches a single spell with API data
+func (s *EnrichmentService) EnrichSpell(spell domain.Spell) domain.EnrichedSpell {
+	enriched := domain.EnrichedSpell{Spell: spell}
+
+	spellDetails, err := s.apiClient.GetSpell(spell.Name)
+	if err != nil {
+		log.Printf("Failed to enrich spell '%s': %v", spell.Name, err)
+		return enriched
+	}
+
+	applyAPIData(&enriched, spellDetails)
+	return enriched
+}
+
+// EnrichSpellsBatch enriches multiple spells concurrently
+func (s *EnrichmentService) EnrichSpellsBatch(spells []domain.Spell) []domain.EnrichedSpell {
+	if len(spells) == 0 {
+		return nil
+	}
+
+	// Extract spell names for batch request
+	spellNames := make([]string, len(spells))
+	spellMap := make(map[string]domain.Spell)
+
+	for i, spell := range spells {
+		spellNames[i] = spell.Name
+		spellMap[spell.Name] = spell
+	}
+
+	// Make batch API request
+	results := s.apiClient.GetSpellsBatch(spellNames)
+
+	// Process results
+	enrichedSpells := make([]domain.EnrichedSpell, 0, len(spells))
+
+	for _, result := range results {
+		enriched := domain.EnrichedSpell{Spell: spellMap[result.Name]}
+
+		if result.Error != nil {
+			log.Printf("Failed to enrich spell '%s': %v", result.Name, result.Error)
+		} else if spellDetails, ok := result.Data.(*api.SpellDetails); ok {
+			applyAPIData(&enriched, spellDetails)
+		}
+
+		enrichedSpells = append(enrichedSpells, enriched)
+	}
+
+	return enrichedSpells
+}
+
+// applyAPIData fills in the spell details from the D&D 5e API
+// The catalog's "At Higher Levels" text is kept when it has one
+func applyAPIData(spell *domain.EnrichedSpell, data *api.SpellDetails) {
+	spell.School = data.School.Name
+	spell.Range = data.Range
+	spell.Components = data.Components
+	spell.Duration = data.Duration
+	spell.CastingTime = data.CastingTime
+	spell.Description = data.Description
+	spell.Ritual = data.Ritual
+	spell.Concentration = data.Concentration
+	if spell.HigherLevel == "" {
+		spell.HigherLevel = strings.Join(data.HigherLevel, " ")
+	}
+}
+
+// SearchSpells searches the catalog for spells by name or class and enriches the matches
+func (s *EnrichmentService) SearchSpells(query string, limit int) ([]domain.EnrichedSpell, error) {
+	queryLower := strings.ToLower(query)
+	return s.enrichMatching(limit, func(spell domain.Spell) bool {
+		return strings.Contains(strings.ToLower(spell.Name), queryLower) || spell.HasClass(query)
+	})
+}
+
+// GetSpellsByClass returns spells on a class's spell list with enrichment
+func (s *EnrichmentService) GetSpellsByClass(class string, limit int) ([]domain.EnrichedSpell, error) {
+	return s.enrichMatching(limit, func(spell domain.Spell) bool { return spell.HasClass(class) })
+}
+
+// enrichMatching enriches up to limit catalog spells that match
+func (s *EnrichmentService) enrichMatching(limit int, match func(domain.Spell) bool) ([]domain.EnrichedSpell, error) {
+	spells, err := s.spells.LoadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load spells: %w", err)
+	}
+
+	var matched []domain.Spell
+	for _, spell := range spells {
+		if match(spell) {
+			matched = append(matched, spell)
+
+			// Limit results for testing
+			if len(matched) >= limit {
+				break
+			}
+		}
+	}
+
+	return s.EnrichSpellsBatch(matched), nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"DnD-sheet/internal/api"
+	"DnD-sheet/internal/spell/domain"
+	"encoding/json"
+	"testing"
+)
+
+func TestApplyAPIData(t *testing.T) {
+	var data api.SpellDetails
+	err := json.Unmarshal([]byte(`{
+		"name": "Hold Person",
+		"level": 2,
+		"school": {"name": "Enchantment"},
+		"range": "60 feet",
+		"components": ["V", "S", "M"],
+		"duration": "Up to 1 minute",
+		"casting_time": "1 action",
+		"desc": ["Choose a humanoid that you can see within range."],
+		"higher_level": ["You can target one additional humanoid for each slot level above 2nd."],
+		"concentration": true
+	}`), &data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	holdPerson := domain.EnrichedSpell{Spell: domain.Spell{Name: "Hold Person", Level: 2}}
+	applyAPIData(&holdPerson, &data)
+	if holdPerson.School != "Enchantment" || holdPerson.Range != "60 feet" || len(holdPerson.Components) != 3 ||
+		holdPerson.CastingTime != "1 action" || len(holdPerson.Description) != 1 || !holdPerson.Concentration {
+		t.Errorf("Unexpected enriched spell %+v", holdPerson)
+	}
+	if holdPerson.HigherLevel != data.HigherLevel[0] {
+		t.Errorf("Expected the API's higher level text for a spell without one, got %q", holdPerson.HigherLevel)
+	}
+
+	// The catalog's own higher level text wins
+	catalog := domain.EnrichedSpell{Spell: domain.Spell{Name: "Hold Person", Level: 2, HigherLevel: "catalog text"}}
+	applyAPIData(&catalog, &data)
+	if catalog.HigherLevel != "catalog text" {
+		t.Errorf("Expected the catalog's higher level text to be kept, got %q", catalog.HigherLevel)
+	}
+}
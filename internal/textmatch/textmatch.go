@@ -0,0 +1,72 @@
+package textmatch
+
+import (
+	"sort"
+	"strings"
+)
+
+// Suggest returns up to limit of the names that look like a misspelling of query, closest first
+func Suggest(names []string, query string, limit int) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	// Allow roughly one typo per three letters, and always match names that contain the query
+	maxDistance := max(2, len(query)/3)
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		distance := levenshtein(query, lower)
+		if strings.Contains(lower, query) {
+			distance = min(distance, 1)
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name, distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var suggestions []string
+	for _, c := range candidates {
+		if len(suggestions) == limit {
+			break
+		}
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+// levenshtein returns the number of single-letter edits needed to turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current := make([]int, len(rb)+1)
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(rb)]
+}
@@ -1,6 +1,8 @@
 package web
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"DnD-sheet/internal/character/domain"
@@ -8,16 +10,29 @@ import (
 
 // WeaponAttack represents weapon attack information for display
 type WeaponAttack struct {
-	Name        string
-	AttackBonus int
-	Damage      string
-	DamageType  string
-	Range       string
-	Properties  []string
-	IsMelee     bool
-	IsRanged    bool
-	IsFinesse   bool
-	IsTwoHanded bool
+	Name            string
+	AttackBonus     int
+	Damage          string
+	VersatileDamage string // damage when wielded with two hands
+	DamageType      string
+	Range           string
+	Properties      []string
+	IsMelee         bool
+	IsRanged        bool
+	IsFinesse       bool
+	IsTwoHanded     bool
+}
+
+// maxResourceBoxes is the largest resource pool drawn as checkboxes; bigger pools such as Lay on Hands show a number
+const maxResourceBoxes = 10
+
+// ResourceDisplay represents a limited-use class resource for display
+type ResourceDisplay struct {
+	Name     string
+	Recharge string
+	Current  int
+	Max      int
+	Boxes    []bool // one checkbox per use, checked while the use is still available
 }
 
 // CharacterTemplateData holds all data needed for the HTML character sheet template
@@ -26,9 +41,19 @@ type CharacterTemplateData struct {
 	Name       string
 	Race       string
 	Class      string
+	ClassLevel string // e.g. "fighter 5" or "fighter 3 / wizard 2"
 	Level      int
 	Background string
 
+	// Racial Details
+	Size         string
+	Darkvision   int
+	Languages    string
+	RacialTraits []domain.RacialTrait
+	Feats        []string // feat name and description
+	Features     []string // class and subclass features with descriptions
+	Resources    []ResourceDisplay
+
 	// Ability Scores
 	Str int
 	Dex int
@@ -52,8 +77,32 @@ type CharacterTemplateData struct {
 	Speed             int
 	HitPointMax       int
 	CurrentHP         int
+	TempHP            int
+	IsDead            bool
+	LifeState         string
+	DeathSuccesses    int
+	DeathFailures     int
+	HitDiceTotal      string
+	HitDiceRemaining  int
 	PassivePerception int
 
+	// Inventory and coins
+	Inventory string // one item per line
+	Coins     domain.Purse
+	Attuned   string // magic items the character is attuned to, e.g. "Cloak of Protection (1/3)"
+
+	// Encumbrance
+	CarriedWeight    string // in pounds
+	CarryingCapacity string
+	Encumbrance      string
+	ArmorWarning     string // penalties for armor worn without proficiency
+
+	// Conditions and the advantage/disadvantage they impose
+	Conditions       []string // condition name and effects
+	AttackRollMode   string   // "advantage", "disadvantage" or empty
+	AbilityCheckMode string   // "disadvantage", or per ability when it differs, e.g. "STR: disadvantage"
+	SaveModes        []string // e.g. "DEX: disadvantage"
+
 	// Equipment
 	Weapon     string
 	WeaponSlot string
@@ -75,8 +124,11 @@ type CharacterTemplateData struct {
 	SpellAttackBonus     int
 	SpellSlots           map[int]int // Max spell slots
 	CurrentSpellSlots    map[int]int // Current available spell slots
+	PactSlots            map[int]int // Warlock Pact Magic slots, key: slot level
+	CurrentPactSlots     map[int]int // Current available Pact Magic slots
 	KnownSpells          []string
 	PreparedSpells       []string
+	Concentration        string // spell the character is concentrating on
 
 	// Saving Throws
 	StrSave int
@@ -86,6 +138,14 @@ type CharacterTemplateData struct {
 	WisSave int
 	ChaSave int
 
+	// Saving Throw Proficiencies
+	StrSaveProf bool
+	DexSaveProf bool
+	ConSaveProf bool
+	IntSaveProf bool
+	WisSaveProf bool
+	ChaSaveProf bool
+
 	// Individual Skill Modifiers for detailed display
 	Acrobatics     int
 	AnimalHandling int
@@ -113,27 +173,29 @@ func NewCharacterTemplateData(char *domain.Character) *CharacterTemplateData {
 		Name:       char.Name,
 		Race:       char.Race,
 		Class:      char.Class,
+		ClassLevel: fmt.Sprintf("%s %d", char.Class, char.Level),
 		Level:      char.Level,
 		Background: char.Background,
-		Str:        char.Str,
-		Dex:        char.Dex,
-		Con:        char.Con,
-		Int:        char.Int,
-		Wis:        char.Wis,
-		Cha:        char.Cha,
+		// Ability scores including magic items that set a score
+		Str: char.AbilityScore("STR"),
+		Dex: char.AbilityScore("DEX"),
+		Con: char.AbilityScore("CON"),
+		Int: char.AbilityScore("INT"),
+		Wis: char.AbilityScore("WIS"),
+		Cha: char.AbilityScore("CHA"),
 
 		// Calculate ability modifiers using domain logic
-		StrMod: domain.Modifier(char.Str),
-		DexMod: domain.Modifier(char.Dex),
-		ConMod: domain.Modifier(char.Con),
-		IntMod: domain.Modifier(char.Int),
-		WisMod: domain.Modifier(char.Wis),
-		ChaMod: domain.Modifier(char.Cha),
+		StrMod: domain.Modifier(char.AbilityScore("STR")),
+		DexMod: domain.Modifier(char.AbilityScore("DEX")),
+		ConMod: domain.Modifier(char.AbilityScore("CON")),
+		IntMod: domain.Modifier(char.AbilityScore("INT")),
+		WisMod: domain.Modifier(char.AbilityScore("WIS")),
+		ChaMod: domain.Modifier(char.AbilityScore("CHA")),
 
 		ProficiencyBonus:  char.ProficiencyBonus,
 		ArmorClass:        char.ArmorClass(),
 		Initiative:        char.Initiative(),
-		Speed:             30, // Default speed, could be race-dependent
+		Speed:             char.Speed(),
 		PassivePerception: char.PassivePerception(),
 
 		// Equipment
@@ -151,12 +213,91 @@ func NewCharacterTemplateData(char *domain.Character) *CharacterTemplateData {
 		// Spellcasting
 		SpellSlots:        char.SpellSlots,
 		CurrentSpellSlots: char.CurrentSpellSlots,
+		PactSlots:         char.PactSlots,
+		CurrentPactSlots:  char.CurrentPactSlots,
 		KnownSpells:       char.KnownSpells,
 		PreparedSpells:    char.PreparedSpells,
+		Concentration:     char.Concentration,
 
-		// Calculate HP
+		// Hit points
 		HitPointMax: char.MaxHitPoints(),
-		CurrentHP:   char.MaxHitPoints(), // Assume full HP for now
+		CurrentHP:   char.CurrentHP,
+		TempHP:      char.TempHP,
+		IsDead:      char.Dead,
+
+		// Death saves
+		LifeState:      string(char.LifeState()),
+		DeathSuccesses: char.DeathSaves.Successes,
+		DeathFailures:  char.DeathSaves.Failures,
+
+		// Hit dice
+		HitDiceTotal:     char.HitDice.String(),
+		HitDiceRemaining: char.HitDice.Remaining(),
+	}
+
+	// Multiclass characters list the level in each class
+	if char.IsMulticlassed() {
+		data.ClassLevel = char.ClassSummary()
+	} else if subclass := char.Subclass(char.Class); subclass != "" {
+		data.ClassLevel += " (" + subclass + ")"
+	}
+
+	// Racial details
+	race := domain.NewRace(char.Race)
+	data.Size = race.Size
+	data.Darkvision = race.Darkvision
+	data.Languages = strings.Join(race.Languages, ", ")
+	data.RacialTraits = race.Traits
+
+	// Class features
+	for _, feature := range char.Features() {
+		data.Features = append(data.Features, fmt.Sprintf("%s: %s", feature, feature.Description))
+	}
+	if char.FightingStyle != "" {
+		data.Features = append(data.Features, "Fighting Style: "+char.FightingStyle)
+	}
+
+	// Inventory
+	var items []string
+	for _, item := range char.Inventory {
+		items = append(items, item.String())
+	}
+	data.Inventory = strings.Join(items, "\n")
+	data.Coins = char.Coins
+	if len(char.Attuned) > 0 {
+		data.Attuned = fmt.Sprintf("%s (%d/%d)", strings.Join(char.Attuned, ", "), len(char.Attuned), domain.MaxAttunedItems)
+	}
+	data.CarriedWeight = strconv.FormatFloat(char.CarriedWeight(), 'f', -1, 64)
+	data.CarryingCapacity = strconv.FormatFloat(char.CarryingCapacity(), 'f', -1, 64)
+	data.Encumbrance = string(char.Encumbrance())
+	data.ArmorWarning = char.ArmorProficiencyWarning()
+
+	// Conditions
+	data.Conditions = char.ConditionSummary()
+	data.AttackRollMode = char.AttackRollMode().String()
+	data.AbilityCheckMode = char.AbilityCheckSummary()
+	for _, ability := range domain.Abilities {
+		if mode := char.SavingThrowMode(ability).String(); mode != "" {
+			data.SaveModes = append(data.SaveModes, ability+": "+mode)
+		}
+	}
+
+	// Class resources
+	for _, r := range char.Resources {
+		display := ResourceDisplay{Name: r.Name, Recharge: string(r.Recharge), Current: r.Current, Max: r.Max}
+		if r.Max <= maxResourceBoxes {
+			for i := 0; i < r.Max; i++ {
+				display.Boxes = append(display.Boxes, i < r.Current)
+			}
+		}
+		data.Resources = append(data.Resources, display)
+	}
+
+	// Feats
+	for _, taken := range char.Feats {
+		if feat, ok := domain.LookupFeat(taken.Name); ok {
+			data.Feats = append(data.Feats, fmt.Sprintf("%s: %s", taken, feat.Description))
+		}
 	}
 
 	// Calculate spellcasting stats if applicable
@@ -177,16 +318,21 @@ func NewCharacterTemplateData(char *domain.Character) *CharacterTemplateData {
 		data.SpellAttackBonus = char.SpellAttackBonus()
 	}
 
-	// Calculate saving throws
-	data.StrSave = data.StrMod
-	data.DexSave = data.DexMod
-	data.ConSave = data.ConMod
-	data.IntSave = data.IntMod
-	data.WisSave = data.WisMod
-	data.ChaSave = data.ChaMod
-
-	// Add proficiency bonus to saving throws (simplified - assumes all classes get prof in 2 saves)
-	// This would need to be expanded for proper class-based saving throw proficiencies
+	// Saving throws with class-based proficiencies from the domain
+	saves := char.SavingThrows()
+	data.StrSave = saves["STR"]
+	data.DexSave = saves["DEX"]
+	data.ConSave = saves["CON"]
+	data.IntSave = saves["INT"]
+	data.WisSave = saves["WIS"]
+	data.ChaSave = saves["CHA"]
+
+	data.StrSaveProf = char.IsProficientInSave("STR")
+	data.DexSaveProf = char.IsProficientInSave("DEX")
+	data.ConSaveProf = char.IsProficientInSave("CON")
+	data.IntSaveProf = char.IsProficientInSave("INT")
+	data.WisSaveProf = char.IsProficientInSave("WIS")
+	data.ChaSaveProf = char.IsProficientInSave("CHA")
 
 	// Calculate individual skill modifiers
 	data.calculateSkillModifiers(char)
@@ -278,72 +424,23 @@ func max(a, b int) int {
 func calculateWeaponAttacks(char *domain.Character) []WeaponAttack {
 	var attacks []WeaponAttack
 
-	// If character has no weapon, return empty attacks
-	if char.Weapon == "" {
-		return attacks
-	}
-
-	// Calculate base attack bonuses using domain logic
-	strMod := domain.Modifier(char.Str)
-	dexMod := domain.Modifier(char.Dex)
-
-	// Try to enrich weapon with API data (simplified for now)
-	attack := WeaponAttack{
-		Name: char.Weapon,
-	}
-
-	// Determine attack type and calculate bonuses based on weapon name
-	weaponLower := strings.ToLower(char.Weapon)
-
-	// Simple weapon categorization (would be better with API enrichment)
-	switch {
-	case strings.Contains(weaponLower, "bow") ||
-		strings.Contains(weaponLower, "crossbow") ||
-		strings.Contains(weaponLower, "sling") ||
-		strings.Contains(weaponLower, "dart") ||
-		strings.Contains(weaponLower, "javelin"):
-		// Ranged weapons use Dex
-		attack.AttackBonus = dexMod + char.ProficiencyBonus
-		attack.IsRanged = true
-		attack.Range = "150/600" // Default ranged weapon range
-
-	case strings.Contains(weaponLower, "dagger") ||
-		strings.Contains(weaponLower, "rapier") ||
-		strings.Contains(weaponLower, "scimitar") ||
-		strings.Contains(weaponLower, "shortsword"):
-		// Finesse weapons can use Dex or Str (choose better)
-		if dexMod > strMod {
-			attack.AttackBonus = dexMod + char.ProficiencyBonus
-		} else {
-			attack.AttackBonus = strMod + char.ProficiencyBonus
-		}
-		attack.IsFinesse = true
-		attack.IsMelee = true
-		attack.Range = "5 ft"
-
-	case strings.Contains(weaponLower, "greataxe") ||
-		strings.Contains(weaponLower, "greatsword") ||
-		strings.Contains(weaponLower, "maul") ||
-		strings.Contains(weaponLower, "pike"):
-		// Two-handed melee weapons use Str
-		attack.AttackBonus = strMod + char.ProficiencyBonus
-		attack.IsTwoHanded = true
-		attack.IsMelee = true
-		attack.Range = "5 ft"
-
-	default:
-		// Default melee weapons use Str
-		attack.AttackBonus = strMod + char.ProficiencyBonus
-		attack.IsMelee = true
-		attack.Range = "5 ft"
+	// Weapon attacks come from the wielded weapon's catalog stats
+	for _, a := range char.WeaponAttacks() {
+		attacks = append(attacks, WeaponAttack{
+			Name:            a.Name,
+			AttackBonus:     a.AttackBonus,
+			Damage:          a.Damage,
+			VersatileDamage: a.VersatileDamage,
+			DamageType:      a.DamageType,
+			Range:           a.Range,
+			Properties:      a.Properties,
+			IsMelee:         a.IsMelee,
+			IsRanged:        a.IsRanged,
+			IsFinesse:       a.IsFinesse,
+			IsTwoHanded:     a.IsTwoHanded,
+		})
 	}
 
-	// Set damage based on weapon (simplified - would be better with API data)
-	attack.Damage = getDamageForWeapon(weaponLower)
-	attack.DamageType = getDamageTypeForWeapon(weaponLower)
-
-	attacks = append(attacks, attack)
-
 	// Add spell attacks if character can cast spells
 	if char.IsSpellcaster() {
 		spellAttack := WeaponAttack{
@@ -359,59 +456,3 @@ func calculateWeaponAttacks(char *domain.Character) []WeaponAttack {
 
 	return attacks
 }
-
-// getDamageForWeapon returns damage dice for common weapons
-func getDamageForWeapon(weaponLower string) string {
-	switch {
-	case strings.Contains(weaponLower, "dagger"):
-		return "1d4"
-	case strings.Contains(weaponLower, "shortsword") || strings.Contains(weaponLower, "scimitar"):
-		return "1d6"
-	case strings.Contains(weaponLower, "longsword") || strings.Contains(weaponLower, "rapier"):
-		return "1d8"
-	case strings.Contains(weaponLower, "greatsword"):
-		return "2d6"
-	case strings.Contains(weaponLower, "greataxe"):
-		return "1d12"
-	case strings.Contains(weaponLower, "bow"):
-		return "1d8"
-	case strings.Contains(weaponLower, "crossbow"):
-		if strings.Contains(weaponLower, "heavy") {
-			return "1d10"
-		}
-		return "1d8"
-	case strings.Contains(weaponLower, "club") || strings.Contains(weaponLower, "quarterstaff"):
-		return "1d4"
-	case strings.Contains(weaponLower, "mace") || strings.Contains(weaponLower, "spear"):
-		return "1d6"
-	case strings.Contains(weaponLower, "warhammer") || strings.Contains(weaponLower, "battleaxe"):
-		return "1d8"
-	case strings.Contains(weaponLower, "maul"):
-		return "2d6"
-	default:
-		return "1d6" // Default damage
-	}
-}
-
-// getDamageTypeForWeapon returns damage type for common weapons
-func getDamageTypeForWeapon(weaponLower string) string {
-	switch {
-	case strings.Contains(weaponLower, "sword") ||
-		strings.Contains(weaponLower, "axe") ||
-		strings.Contains(weaponLower, "scimitar"):
-		return "Slashing"
-	case strings.Contains(weaponLower, "dagger") ||
-		strings.Contains(weaponLower, "spear") ||
-		strings.Contains(weaponLower, "rapier") ||
-		strings.Contains(weaponLower, "arrow") ||
-		strings.Contains(weaponLower, "bow"):
-		return "Piercing"
-	case strings.Contains(weaponLower, "mace") ||
-		strings.Contains(weaponLower, "club") ||
-		strings.Contains(weaponLower, "hammer") ||
-		strings.Contains(weaponLower, "maul"):
-		return "Bludgeoning"
-	default:
-		return "Bludgeoning" // Default damage type
-	}
-}
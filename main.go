@@ -4,6 +4,9 @@ import (
 	"DnD-sheet/internal/character/infrastructure"
 	"DnD-sheet/internal/character/service"
 	"DnD-sheet/internal/cli"
+	equipmentinfra "DnD-sheet/internal/equipment/infrastructure"
+	equipmentservice "DnD-sheet/internal/equipment/service"
+	spellinfra "DnD-sheet/internal/spell/infrastructure"
 	"fmt"
 	"os"
 	"strings"
@@ -11,10 +14,19 @@ import (
 
 const dataDir = "../data"
 
+// equipmentCSV is the equipment catalog inventory items and worn armor refer to
+const equipmentCSV = "internal/equipment/5e-SRD-Equipment.csv"
+
+// spellCSV is the spell catalog spell levels are looked up in
+const spellCSV = "internal/spell/5e-SRD-Spells.csv"
+
 func main() {
 	// Initialize dependencies using the new refactored architecture
-	characterRepo := infrastructure.NewJSONCharacterRepository(dataDir)
-	characterService := service.NewCharacterService(characterRepo)
+	equipmentRepo := equipmentinfra.NewCSVEquipmentRepository(equipmentCSV)
+	characterRepo := infrastructure.NewJSONCharacterRepositoryWithEquipment(dataDir, equipmentRepo)
+	spellRepo := spellinfra.NewCSVSpellRepository(spellCSV)
+	characterService := service.NewCharacterService(characterRepo, equipmentRepo, spellRepo)
+	equipmentService := equipmentservice.NewEquipmentService(equipmentRepo)
 
 	// Create CLI instance
 	cliApp := cli.NewCLI()
@@ -25,11 +37,43 @@ func main() {
 	cliApp.Register(cli.NewListCommand(characterService))
 	cliApp.Register(cli.NewDeleteCommand(characterService))
 	cliApp.Register(cli.NewUpdateCommand(characterService))
+	cliApp.Register(cli.NewASICommand(characterService))
+	cliApp.Register(cli.NewSubclassCommand(characterService))
+	cliApp.Register(cli.NewFightingStyleCommand(characterService))
 	cliApp.Register(cli.NewEquipCommand(characterService))
+	cliApp.Register(cli.NewUnequipCommand(characterService))
+	cliApp.Register(cli.NewSwapCommand(characterService))
+	cliApp.Register(cli.NewAttackCommand(characterService))
+	cliApp.Register(cli.NewRecoverAmmoCommand(characterService))
+	cliApp.Register(cli.NewAddItemCommand(characterService))
+	cliApp.Register(cli.NewRemoveItemCommand(characterService))
+	cliApp.Register(cli.NewGiveCommand(characterService))
+	cliApp.Register(cli.NewPayCommand(characterService))
+	cliApp.Register(cli.NewUseItemCommand(characterService))
+	cliApp.Register(cli.NewAttuneCommand(characterService))
+	cliApp.Register(cli.NewUnattuneCommand(characterService))
+	cliApp.Register(cli.NewEncumbranceCommand(characterService))
 	cliApp.Register(cli.NewPrepareSpellCommand(characterService))
 	cliApp.Register(cli.NewLearnSpellCommand(characterService))
+	cliApp.Register(cli.NewUnprepareSpellCommand(characterService))
+	cliApp.Register(cli.NewForgetSpellCommand(characterService))
+	cliApp.Register(cli.NewSwapSpellCommand(characterService))
 	cliApp.Register(cli.NewCastSpellCommand(characterService))
+	cliApp.Register(cli.NewConcentrationCheckCommand(characterService))
+	cliApp.Register(cli.NewEndConcentrationCommand(characterService))
+	cliApp.Register(cli.NewDamageCommand(characterService))
+	cliApp.Register(cli.NewHealCommand(characterService))
+	cliApp.Register(cli.NewTempHPCommand(characterService))
+	cliApp.Register(cli.NewMaxHPCommand(characterService))
+	cliApp.Register(cli.NewDeathSaveCommand(characterService))
+	cliApp.Register(cli.NewAddConditionCommand(characterService))
+	cliApp.Register(cli.NewRemoveConditionCommand(characterService))
+	cliApp.Register(cli.NewShortRestCommand(characterService))
+	cliApp.Register(cli.NewLongRestCommand(characterService))
+	cliApp.Register(cli.NewUseResourceCommand(characterService))
+	cliApp.Register(cli.NewRestoreResourceCommand(characterService))
 	cliApp.Register(cli.NewWebCommand(characterService))
+	cliApp.Register(cli.NewAPITestCommand(equipmentService, spellRepo))
 
 	// Run CLI
 	if err := cliApp.Run(os.Args); err != nil {